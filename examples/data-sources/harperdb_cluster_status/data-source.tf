@@ -0,0 +1,26 @@
+data "harperdb_cluster_status" "this" {}
+
+# Verify replication is healthy before rolling out schema changes.
+check "cluster" {
+  assert {
+    condition     = data.harperdb_cluster_status.this.all_connected
+    error_message = "Not every cluster node is connected."
+  }
+
+  assert {
+    condition = alltrue([
+      for connection in data.harperdb_cluster_status.this.connections :
+      connection.latency_ms == null || connection.latency_ms < 500
+    ])
+    error_message = "The latency to a cluster node exceeds 500ms."
+  }
+}
+
+# Include the view of every node of the cluster.
+data "harperdb_cluster_status" "network" {
+  include_network = true
+}
+
+output "cluster_nodes" {
+  value = [for node in data.harperdb_cluster_status.network.network : node.name]
+}
@@ -0,0 +1,13 @@
+data "harperdb_configuration" "this" {}
+
+check "compliance" {
+  assert {
+    condition     = data.harperdb_configuration.this.logging_level != "trace"
+    error_message = "Trace logging must not be enabled."
+  }
+
+  assert {
+    condition     = data.harperdb_configuration.this.values["authentication.operationTokenTimeout"] == "1d"
+    error_message = "Operation tokens must expire after one day."
+  }
+}
@@ -0,0 +1,7 @@
+data "harperdb_instance_ready" "this" {
+  timeout = "2m"
+}
+
+output "harperdb_version" {
+  value = data.harperdb_instance_ready.this.version
+}
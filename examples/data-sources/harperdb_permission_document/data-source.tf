@@ -0,0 +1,29 @@
+data "harperdb_permission_document" "analytics" {
+  statement {
+    schema     = "analytics"
+    tables     = ["events", "sessions"]
+    operations = ["read"]
+  }
+}
+
+data "harperdb_permission_document" "combined" {
+  source_documents = [data.harperdb_permission_document.analytics.json]
+
+  statement {
+    schema     = "dogs"
+    tables     = ["breeds"]
+    operations = ["read", "insert", "update"]
+  }
+
+  statement {
+    schema     = "dogs"
+    tables     = ["owners"]
+    operations = ["read"]
+    attributes = ["name"]
+  }
+}
+
+resource "harperdb_role" "combined" {
+  name            = "combined"
+  permission_json = data.harperdb_permission_document.combined.json
+}
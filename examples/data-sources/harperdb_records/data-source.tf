@@ -0,0 +1,43 @@
+# Records by primary key.
+data "harperdb_records" "settings" {
+  schema      = "dev"
+  table       = "settings"
+  hash_values = [jsonencode("smtp"), jsonencode("s3")]
+}
+
+# Records by attribute value, * is a wildcard.
+data "harperdb_records" "pennies" {
+  schema           = "dev"
+  table            = "dogs"
+  get_attributes   = ["id", "name"]
+  search_attribute = "name"
+  search_value     = jsonencode("Pen*")
+}
+
+# The three oldest dogs between 5 and 10 years old or owned by Kyle.
+data "harperdb_records" "oldest" {
+  schema   = "dev"
+  table    = "dogs"
+  operator = "or"
+  conditions = [
+    {
+      attribute = "age"
+      type      = "between"
+      value     = jsonencode([5, 10])
+    },
+    {
+      attribute = "owner_name"
+      type      = "equals"
+      value     = jsonencode("Kyle")
+    },
+  ]
+  sort = {
+    attribute  = "age"
+    descending = true
+  }
+  limit = 3
+}
+
+output "oldest_names" {
+  value = [for row in data.harperdb_records.oldest.rows : jsondecode(row).name]
+}
@@ -0,0 +1,9 @@
+# Look up a role by name.
+data "harperdb_role" "developer" {
+  name = "developer"
+}
+
+# Look up a role by ID.
+data "harperdb_role" "by_id" {
+  id = harperdb_role.developer.id
+}
@@ -0,0 +1,2 @@
+# All roles, sorted by name.
+data "harperdb_roles" "all" {}
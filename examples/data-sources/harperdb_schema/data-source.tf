@@ -0,0 +1,7 @@
+data "harperdb_schema" "dogs" {
+  name = "dogs"
+}
+
+output "breeds_hash_attribute" {
+  value = data.harperdb_schema.dogs.tables["breeds"].hash_attribute
+}
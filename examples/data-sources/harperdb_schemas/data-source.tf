@@ -0,0 +1,4 @@
+# All schemas whose name starts with "team_".
+data "harperdb_schemas" "teams" {
+  name_regex = "^team_"
+}
@@ -0,0 +1,13 @@
+variable "tenant" {
+  type = string
+}
+
+data "harperdb_sql_query" "flags" {
+  query      = "SELECT name, enabled FROM dev.feature_flags WHERE tenant = ? ORDER BY name"
+  parameters = [var.tenant]
+  max_rows   = 100
+}
+
+output "enabled_flags" {
+  value = [for row in data.harperdb_sql_query.flags.rows : row.name if row.enabled == "true"]
+}
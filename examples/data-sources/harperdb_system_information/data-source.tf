@@ -0,0 +1,28 @@
+data "harperdb_system_information" "this" {
+  sections = ["system", "memory", "disk"]
+}
+
+# Warn when the instance runs out of resources.
+check "resources" {
+  assert {
+    condition     = data.harperdb_system_information.this.memory.available > 2 * 1024 * 1024 * 1024
+    error_message = "Less than 2 GiB of memory available."
+  }
+
+  assert {
+    condition     = alltrue([for fs in data.harperdb_system_information.this.disk.filesystems : fs.use < 90])
+    error_message = "A filesystem is more than 90% full."
+  }
+}
+
+# Refuse to deploy to unsupported versions.
+resource "harperdb_schema" "dev" {
+  name = "dev"
+
+  lifecycle {
+    precondition {
+      condition     = tonumber(split(".", data.harperdb_system_information.this.system.hdb_version)[0]) >= 4
+      error_message = "HarperDB 4.x or later is required."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "harperdb_table" "breeds" {
+  schema = "dogs"
+  name   = "breeds"
+}
+
+output "breeds_attributes" {
+  value = data.harperdb_table.breeds.attributes
+}
@@ -0,0 +1,6 @@
+data "harperdb_user" "ada" {
+  username = "ada"
+}
+
+# Without a username, the user the provider is authenticated as is returned.
+data "harperdb_user" "current" {}
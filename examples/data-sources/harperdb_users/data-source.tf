@@ -0,0 +1,5 @@
+# Active users assigned the "developer" role.
+data "harperdb_users" "developers" {
+  role   = "developer"
+  active = true
+}
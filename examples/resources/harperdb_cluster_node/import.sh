@@ -0,0 +1,2 @@
+# Cluster nodes are imported by their name.
+terraform import harperdb_cluster_node.eu node-eu-1
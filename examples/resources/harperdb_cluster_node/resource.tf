@@ -0,0 +1,17 @@
+resource "harperdb_cluster_node" "eu" {
+  node_name = "node-eu-1"
+
+  subscription {
+    schema    = "dev"
+    table     = "dog"
+    publish   = true
+    subscribe = true
+  }
+
+  # Only receive changes made on the other node.
+  subscription {
+    schema    = "dev"
+    table     = "breed"
+    subscribe = true
+  }
+}
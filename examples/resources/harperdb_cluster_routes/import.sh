@@ -0,0 +1,2 @@
+# The routes are global to the instance, any ID imports all of them.
+terraform import harperdb_cluster_routes.this routes
@@ -0,0 +1,13 @@
+resource "harperdb_cluster_routes" "this" {
+  route {
+    server = "hub"
+    host   = "node-eu-1.example.com"
+    port   = 9932
+  }
+
+  route {
+    server = "hub"
+    host   = "node-us-1.example.com"
+    port   = 9932
+  }
+}
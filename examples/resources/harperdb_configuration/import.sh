@@ -0,0 +1,2 @@
+# Settings are imported by their comma separated paths.
+terraform import harperdb_configuration.this logging.level,clustering.enabled
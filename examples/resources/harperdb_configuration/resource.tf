@@ -0,0 +1,14 @@
+resource "harperdb_configuration" "this" {
+  settings = {
+    "logging.level"                        = "warn"
+    "clustering.enabled"                   = "true"
+    "authentication.operationTokenTimeout" = "1d"
+    "http.corsAccessList"                  = jsonencode(["app.example.com"])
+  }
+
+  # Apply the settings by restarting HarperDB.
+  restart = "instance"
+
+  # Restore the previous values on destroy.
+  restore_on_destroy = true
+}
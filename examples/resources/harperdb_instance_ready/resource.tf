@@ -0,0 +1,13 @@
+# Wait for an instance provisioned in the same apply, for example a container.
+resource "harperdb_instance_ready" "this" {
+  triggers = {
+    instance = docker_container.harperdb.id
+  }
+  timeout = "15m"
+}
+
+resource "harperdb_schema" "dev" {
+  name = "dev"
+
+  depends_on = [harperdb_instance_ready.this]
+}
@@ -0,0 +1,21 @@
+resource "harperdb_configuration" "logging" {
+  settings = {
+    "logging.level" = "warn"
+  }
+}
+
+resource "harperdb_configuration" "clustering" {
+  settings = {
+    "clustering.enabled"  = "true"
+    "clustering.nodeName" = "node-1"
+  }
+}
+
+# Restart once after any of the settings changed.
+resource "harperdb_restart" "this" {
+  triggers = {
+    logging    = jsonencode(harperdb_configuration.logging.settings)
+    clustering = jsonencode(harperdb_configuration.clustering.settings)
+  }
+  timeout = "10m"
+}
@@ -0,0 +1,2 @@
+# Roles can be imported by their ID or by their name
+terraform import harperdb_role.reader reader
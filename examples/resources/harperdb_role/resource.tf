@@ -0,0 +1,65 @@
+resource "harperdb_role" "reader" {
+  name = "reader"
+  schema_permissions = {
+    dogs = {
+      tables = {
+        breeds = {
+          read = true
+          attribute_permissions = [
+            {
+              name = "owner"
+              read = false
+            }
+          ]
+        }
+      }
+    }
+  }
+}
+
+# Permissions can also be provided as a native HarperDB permission document,
+# for example one exported from HarperDB Studio.
+resource "harperdb_role" "studio" {
+  name            = "studio"
+  permission_json = file("${path.module}/studio-role.json")
+}
+
+# Structure users may create and drop tables, either in every schema with
+# `structure_user = true` or only in the listed schemas.
+resource "harperdb_role" "dogs_owner" {
+  name                   = "dogs_owner"
+  structure_user_schemas = ["dogs"]
+}
+
+# Every other table of the schema, including tables created later, is granted
+# read access. New tables show up in the plan and are granted by the next apply.
+resource "harperdb_role" "analyst" {
+  name = "analyst"
+  schema_permissions = {
+    dogs = {
+      tables = {
+        owners = {
+          read = true
+          # Only the listed attributes are readable, attributes added to the
+          # table later are denied as well.
+          attribute_mode = "deny_by_default"
+          attribute_permissions = [
+            {
+              name = "name"
+              read = true
+            }
+          ]
+        }
+      }
+      default_table_permissions = {
+        read = true
+      }
+    }
+  }
+}
+
+# Users still assigned to the role are moved to "reader" when it is destroyed.
+resource "harperdb_role" "temporary" {
+  name                   = "temporary"
+  on_destroy_reassign_to = harperdb_role.reader.name
+}
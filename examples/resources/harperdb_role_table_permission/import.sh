@@ -0,0 +1,2 @@
+# Table permissions can be imported by role ID or name, schema and table.
+terraform import harperdb_role_table_permission.breeds analyst/dogs/breeds
@@ -0,0 +1,27 @@
+resource "harperdb_role" "analyst" {
+  name = "analyst"
+
+  # The role owns dogs.owners, dogs.breeds is granted below.
+  schema_permissions = {
+    dogs = {
+      tables = {
+        owners = {
+          read = true
+        }
+      }
+    }
+  }
+}
+
+resource "harperdb_role_table_permission" "breeds" {
+  role_id = harperdb_role.analyst.id
+  schema  = "dogs"
+  table   = "breeds"
+  read    = true
+  attribute_permissions = [
+    {
+      name = "owner"
+      read = false
+    }
+  ]
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterNodeResource{}
+var _ resource.ResourceWithImportState = &ClusterNodeResource{}
+var _ resource.ResourceWithValidateConfig = &ClusterNodeResource{}
+
+func NewClusterNodeResource() resource.Resource {
+	return &ClusterNodeResource{}
+}
+
+// ClusterNodeResource defines the resource implementation.
+// It manages the subscriptions of the instance with another cluster node.
+type ClusterNodeResource struct {
+	client *harperdb.Client
+}
+
+// ClusterNodeResourceModel describes the resource data model.
+type ClusterNodeResourceModel struct {
+	ID            types.String                   `tfsdk:"id"`
+	NodeName      types.String                   `tfsdk:"node_name"`
+	Subscriptions []ClusterNodeSubscriptionModel `tfsdk:"subscription"`
+}
+
+// ClusterNodeSubscriptionModel describes a subscription block.
+type ClusterNodeSubscriptionModel struct {
+	Schema    types.String `tfsdk:"schema"`
+	Table     types.String `tfsdk:"table"`
+	Publish   types.Bool   `tfsdk:"publish"`
+	Subscribe types.Bool   `tfsdk:"subscribe"`
+}
+
+func (r *ClusterNodeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_node"
+}
+
+func (r *ClusterNodeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Connects the instance with another node of the cluster and replicates tables with it. " +
+			"Requires HarperDB 4 with clustering enabled on both nodes.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the remote node, its `clustering.nodeName` setting",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"subscription": schema.SetNestedBlock{
+				MarkdownDescription: "Replication of a table with the node. Each table may be given once.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"schema": schema.StringAttribute{
+							MarkdownDescription: "Schema of the table",
+							Required:            true,
+						},
+						"table": schema.StringAttribute{
+							MarkdownDescription: "Name of the table",
+							Required:            true,
+						},
+						"publish": schema.BoolAttribute{
+							MarkdownDescription: "Whether changes to the table are sent to the node",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"subscribe": schema.BoolAttribute{
+							MarkdownDescription: "Whether changes to the table are received from the node",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ClusterNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects tables given more than once, and subscriptions
+// neither publishing nor subscribing, which HarperDB does not report back.
+func (r *ClusterNodeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var set types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("subscription"), &set)...)
+
+	if resp.Diagnostics.HasError() || set.IsUnknown() {
+		return
+	}
+
+	var subscriptions []ClusterNodeSubscriptionModel
+	resp.Diagnostics.Append(set.ElementsAs(ctx, &subscriptions, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[[2]string]bool{}
+	for _, subscription := range subscriptions {
+		if !subscription.Publish.ValueBool() && !subscription.Subscribe.ValueBool() && allKnown(subscription.Publish, subscription.Subscribe) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subscription"),
+				"Invalid Subscription",
+				fmt.Sprintf("The subscription of %s.%s must publish, subscribe or both.", subscription.Schema.ValueString(), subscription.Table.ValueString()),
+			)
+		}
+
+		if !allKnown(subscription.Schema, subscription.Table) {
+			continue
+		}
+
+		key := [2]string{subscription.Schema.ValueString(), subscription.Table.ValueString()}
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subscription"),
+				"Duplicate Subscription",
+				fmt.Sprintf("The table %s.%s is given more than once.", key[0], key[1]),
+			)
+		}
+		seen[key] = true
+	}
+}
+
+func (r *ClusterNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterNodeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RawRequest(opAddNode{
+		NodeName:      data.NodeName.ValueString(),
+		Subscriptions: expandClusterSubscriptions(data.Subscriptions),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add node, got error: %s", err))
+		return
+	}
+
+	data.ID = data.NodeName
+
+	tflog.Trace(ctx, "added a cluster node")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterNodeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connection, err := findClusterConnection(r.client, data.NodeName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster status, got error: %s", err))
+		return
+	}
+
+	if connection == nil {
+		tflog.Warn(ctx, fmt.Sprintf("cluster node %s no longer exists, removing it from state", data.NodeName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(connection.NodeName)
+	data.Subscriptions = flattenClusterSubscriptions(connection.Subscriptions)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ClusterNodeResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RawRequest(opUpdateNode{
+		NodeName: data.NodeName.ValueString(),
+		Subscriptions: clusterSubscriptionUpdates(
+			expandClusterSubscriptions(state.Subscriptions),
+			expandClusterSubscriptions(data.Subscriptions),
+		),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update node, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterNodeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.RemoveNode(data.NodeName.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove node, got error: %s", err))
+		return
+	}
+}
+
+func (r *ClusterNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("node_name"), req, resp)
+}
+
+// findClusterConnection returns the connection with the named node, or nil
+// if the instance does not replicate with it.
+func findClusterConnection(client *harperdb.Client, nodeName string) (*clusterConnection, error) {
+	var status clusterStatusResponse
+	if err := client.RawRequest(opClusterStatus{}, &status); err != nil {
+		return nil, err
+	}
+
+	if !status.IsEnabled {
+		return nil, fmt.Errorf("clustering is not enabled")
+	}
+
+	for i := range status.Connections {
+		if status.Connections[i].NodeName == nodeName {
+			return &status.Connections[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func expandClusterSubscriptions(subscriptions []ClusterNodeSubscriptionModel) []clusterSubscription {
+	expanded := make([]clusterSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		expanded = append(expanded, clusterSubscription{
+			Schema:    subscription.Schema.ValueString(),
+			Table:     subscription.Table.ValueString(),
+			Publish:   subscription.Publish.ValueBool(),
+			Subscribe: subscription.Subscribe.ValueBool(),
+		})
+	}
+
+	return expanded
+}
+
+// flattenClusterSubscriptions skips subscriptions neither publishing nor
+// subscribing, which is how update_node removes them.
+func flattenClusterSubscriptions(subscriptions []clusterSubscription) []ClusterNodeSubscriptionModel {
+	flattened := []ClusterNodeSubscriptionModel{}
+	for _, subscription := range subscriptions {
+		if !subscription.Publish && !subscription.Subscribe {
+			continue
+		}
+
+		flattened = append(flattened, ClusterNodeSubscriptionModel{
+			Schema:    types.StringValue(subscription.Schema),
+			Table:     types.StringValue(subscription.Table),
+			Publish:   types.BoolValue(subscription.Publish),
+			Subscribe: types.BoolValue(subscription.Subscribe),
+		})
+	}
+
+	return flattened
+}
+
+// clusterSubscriptionUpdates returns the subscriptions to send to
+// update_node. Since it leaves tables it is not given untouched, the tables
+// which are no longer planned are sent neither publishing nor subscribing.
+func clusterSubscriptionUpdates(prior, planned []clusterSubscription) []clusterSubscription {
+	updates := append([]clusterSubscription(nil), planned...)
+
+	keep := map[[2]string]bool{}
+	for _, subscription := range planned {
+		keep[[2]string{subscription.Schema, subscription.Table}] = true
+	}
+
+	for _, subscription := range prior {
+		if keep[[2]string{subscription.Schema, subscription.Table}] {
+			continue
+		}
+
+		updates = append(updates, clusterSubscription{
+			Schema: subscription.Schema,
+			Table:  subscription.Table,
+		})
+	}
+
+	return updates
+}
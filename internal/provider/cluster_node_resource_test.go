@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccClusterNodeResource needs a second HarperDB 4 node with clustering
+// enabled, whose name is given by HARPERDB_CLUSTER_NODE.
+func TestAccClusterNodeResource(t *testing.T) {
+	nodeName := os.Getenv("HARPERDB_CLUSTER_NODE")
+	if nodeName == "" {
+		t.Skip("HARPERDB_CLUSTER_NODE must be set to run cluster node acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterNodeResourceConfig(nodeName, `
+  subscription {
+    schema    = harperdb_table.test.schema
+    table     = harperdb_table.test.name
+    publish   = true
+    subscribe = true
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_cluster_node.test", "id", nodeName),
+					resource.TestCheckResourceAttr("harperdb_cluster_node.test", "subscription.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("harperdb_cluster_node.test", "subscription.*", map[string]string{
+						"table":     "cluster_node",
+						"publish":   "true",
+						"subscribe": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      "harperdb_cluster_node.test",
+				ImportState:       true,
+				ImportStateId:     nodeName,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterNodeResourceConfig(nodeName, `
+  subscription {
+    schema  = harperdb_table.test.schema
+    table   = harperdb_table.test.name
+    publish = true
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("harperdb_cluster_node.test", "subscription.*", map[string]string{
+						"publish":   "true",
+						"subscribe": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterNodeResourceConfig(nodeName, subscriptions string) string {
+	return fmt.Sprintf(`
+	%s
+
+resource "harperdb_schema" "test" {
+  name = "tf_acc_cluster"
+}
+
+resource "harperdb_table" "test" {
+  schema         = harperdb_schema.test.name
+  name           = "cluster_node"
+  hash_attribute = "id"
+}
+
+resource "harperdb_cluster_node" "test" {
+  node_name = %q
+%s}
+`, testAccProviderTF(), nodeName, subscriptions)
+}
+
+func TestAccClusterNodeResource_validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_cluster_node" "test" {
+  node_name = "node-2"
+
+  subscription {
+    schema = "dev"
+    table  = "dog"
+  }
+}
+`, testAccProviderTF()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Subscription"),
+			},
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_cluster_node" "test" {
+  node_name = "node-2"
+
+  subscription {
+    schema  = "dev"
+    table   = "dog"
+    publish = true
+  }
+
+  subscription {
+    schema    = "dev"
+    table     = "dog"
+    subscribe = true
+  }
+}
+`, testAccProviderTF()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Duplicate Subscription"),
+			},
+		},
+	})
+}
+
+func TestClusterSubscriptionUpdates(t *testing.T) {
+	prior := []clusterSubscription{
+		{Schema: "dev", Table: "dog", Publish: true, Subscribe: true},
+		{Schema: "dev", Table: "cat", Publish: true},
+	}
+	planned := []clusterSubscription{
+		{Schema: "dev", Table: "dog", Subscribe: true},
+		{Schema: "dev", Table: "bird", Publish: true},
+	}
+
+	want := []clusterSubscription{
+		{Schema: "dev", Table: "dog", Subscribe: true},
+		{Schema: "dev", Table: "bird", Publish: true},
+		{Schema: "dev", Table: "cat"},
+	}
+	if got := clusterSubscriptionUpdates(prior, planned); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestFlattenClusterSubscriptions(t *testing.T) {
+	got := flattenClusterSubscriptions([]clusterSubscription{
+		{Schema: "dev", Table: "dog", Publish: true},
+		{Schema: "dev", Table: "cat"},
+	})
+
+	want := []ClusterNodeSubscriptionModel{
+		{
+			Schema:    types.StringValue("dev"),
+			Table:     types.StringValue("dog"),
+			Publish:   types.BoolValue(true),
+			Subscribe: types.BoolValue(false),
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	if got := flattenClusterSubscriptions(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty, non-nil list, got %#v", got)
+	}
+}
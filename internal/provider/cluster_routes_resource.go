@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// clusterRoutesID is the ID of harperdb_cluster_routes, the routes being
+// global to the instance.
+const clusterRoutesID = "routes"
+
+// Servers a route can be set on.
+var clusterRouteServers = []string{"hub", "leaf"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterRoutesResource{}
+var _ resource.ResourceWithImportState = &ClusterRoutesResource{}
+var _ resource.ResourceWithValidateConfig = &ClusterRoutesResource{}
+
+func NewClusterRoutesResource() resource.Resource {
+	return &ClusterRoutesResource{}
+}
+
+// ClusterRoutesResource defines the resource implementation.
+// It manages all clustering routes of the instance.
+type ClusterRoutesResource struct {
+	client *harperdb.Client
+}
+
+// ClusterRoutesResourceModel describes the resource data model.
+type ClusterRoutesResourceModel struct {
+	ID     types.String        `tfsdk:"id"`
+	Routes []ClusterRouteModel `tfsdk:"route"`
+}
+
+// ClusterRouteModel describes a route block.
+type ClusterRouteModel struct {
+	Server types.String `tfsdk:"server"`
+	Host   types.String `tfsdk:"host"`
+	Port   types.Int64  `tfsdk:"port"`
+}
+
+// clusterRoute is a route as compared between the state and the instance.
+type clusterRoute struct {
+	Server string
+	Host   string
+	Port   int
+}
+
+func (r *ClusterRoutesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_routes"
+}
+
+func (r *ClusterRoutesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the clustering routes of the instance. Creating the resource fails while the instance has routes " +
+			"missing from the configuration; import the resource to start from the existing routes. Once created, routes missing " +
+			"from the configuration are removed, including routes set outside of Terraform. " +
+			"Only one `harperdb_cluster_routes` should exist per instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Always `%s`", clusterRoutesID),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"route": schema.SetNestedBlock{
+				MarkdownDescription: "Route to another node. Each host and port may be given once.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"server": schema.StringAttribute{
+							MarkdownDescription: "Server the route is set on, `hub` or `leaf`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(clusterRouteServers...),
+							},
+						},
+						"host": schema.StringAttribute{
+							MarkdownDescription: "Host of the node",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Clustering port of the node",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ClusterRoutesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects hosts and ports given more than once, HarperDB
+// deleting routes by host and port only.
+func (r *ClusterRoutesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var set types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("route"), &set)...)
+
+	if resp.Diagnostics.HasError() || set.IsUnknown() {
+		return
+	}
+
+	var routes []ClusterRouteModel
+	resp.Diagnostics.Append(set.ElementsAs(ctx, &routes, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[harperdb.Route]bool{}
+	for _, route := range routes {
+		if !allKnown(route.Host, route.Port) {
+			continue
+		}
+
+		key := harperdb.Route{Host: route.Host.ValueString(), Port: int(route.Port.ValueInt64())}
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("route"),
+				"Duplicate Route",
+				fmt.Sprintf("The route to %s:%d is given more than once.", key.Host, key.Port),
+			)
+		}
+		seen[key] = true
+	}
+}
+
+func (r *ClusterRoutesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterRoutesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := getClusterRoutes(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routes, got error: %s", err))
+		return
+	}
+
+	// Routes set outside of Terraform are never removed on create, they have
+	// to be imported or added to the configuration first.
+	planned := expandClusterRoutes(data.Routes)
+	if unmanaged := unmanagedClusterRoutes(current, planned); len(unmanaged) > 0 {
+		resp.Diagnostics.AddError(
+			"Unmanaged Routes",
+			fmt.Sprintf("The instance already has the routes %s, which are not in the configuration. Add them to the configuration, "+
+				"or import the existing routes with `terraform import harperdb_cluster_routes.<name> %s` and remove them afterwards.",
+				strings.Join(unmanaged, ", "), clusterRoutesID),
+		)
+		return
+	}
+
+	if err := applyClusterRoutes(r.client, current, planned); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set routes, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(clusterRoutesID)
+
+	tflog.Trace(ctx, "set cluster routes")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterRoutesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterRoutesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := getClusterRoutes(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routes, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(clusterRoutesID)
+	data.Routes = flattenClusterRoutes(routes)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterRoutesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ClusterRoutesResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := applyClusterRoutes(r.client, expandClusterRoutes(state.Routes), expandClusterRoutes(data.Routes)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update routes, got error: %s", err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterRoutesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ClusterRoutesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := applyClusterRoutes(r.client, expandClusterRoutes(data.Routes), nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete routes, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts any ID, the routes being read from the instance.
+func (r *ClusterRoutesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), clusterRoutesID)...)
+}
+
+// getClusterRoutes returns the routes of the instance.
+func getClusterRoutes(client *harperdb.Client) ([]clusterRoute, error) {
+	var response clusterRoutesResponse
+	if err := client.RawRequest(harperdb.OpGetRoutes{}, &response); err != nil {
+		return nil, err
+	}
+
+	routes := make([]clusterRoute, 0, len(response.Hub)+len(response.Leaf))
+	for _, route := range response.Hub {
+		routes = append(routes, clusterRoute{Server: "hub", Host: route.Host, Port: route.Port})
+	}
+	for _, route := range response.Leaf {
+		routes = append(routes, clusterRoute{Server: "leaf", Host: route.Host, Port: route.Port})
+	}
+
+	return routes, nil
+}
+
+// applyClusterRoutes changes the routes of the instance from prior to
+// planned, deleting and setting the difference only.
+func applyClusterRoutes(client *harperdb.Client, prior, planned []clusterRoute) error {
+	deleted, set := diffClusterRoutes(prior, planned)
+
+	// Routes moving to another server are deleted first.
+	if len(deleted) > 0 {
+		if _, err := client.DeleteRoutes(deleted); err != nil {
+			return err
+		}
+	}
+
+	for _, server := range clusterRouteServers {
+		if len(set[server]) == 0 {
+			continue
+		}
+
+		response, err := client.SetRoutes(harperdb.OpSetRoutes{Server: server, Routes: set[server]})
+		if err != nil {
+			return err
+		}
+
+		if len(response.Skipped) > 0 {
+			return fmt.Errorf("%d %s routes were skipped: %v", len(response.Skipped), server, response.Skipped)
+		}
+	}
+
+	return nil
+}
+
+// diffClusterRoutes returns the routes to delete and the routes to set per
+// server to go from prior to planned.
+func diffClusterRoutes(prior, planned []clusterRoute) ([]harperdb.Route, map[string][]harperdb.Route) {
+	inPrior := map[clusterRoute]bool{}
+	for _, route := range prior {
+		inPrior[route] = true
+	}
+
+	inPlanned := map[clusterRoute]bool{}
+	for _, route := range planned {
+		inPlanned[route] = true
+	}
+
+	var deleted []harperdb.Route
+	for _, route := range prior {
+		if !inPlanned[route] {
+			deleted = append(deleted, harperdb.Route{Host: route.Host, Port: route.Port})
+		}
+	}
+
+	set := map[string][]harperdb.Route{}
+	for _, route := range planned {
+		if !inPrior[route] {
+			set[route.Server] = append(set[route.Server], harperdb.Route{Host: route.Host, Port: route.Port})
+		}
+	}
+
+	return deleted, set
+}
+
+// unmanagedClusterRoutes returns the routes of current missing from planned,
+// formatted as server, host and port.
+func unmanagedClusterRoutes(current, planned []clusterRoute) []string {
+	inPlanned := map[clusterRoute]bool{}
+	for _, route := range planned {
+		inPlanned[route] = true
+	}
+
+	var unmanaged []string
+	for _, route := range current {
+		if !inPlanned[route] {
+			unmanaged = append(unmanaged, fmt.Sprintf("%s %s:%d", route.Server, route.Host, route.Port))
+		}
+	}
+	sort.Strings(unmanaged)
+
+	return unmanaged
+}
+
+func expandClusterRoutes(routes []ClusterRouteModel) []clusterRoute {
+	expanded := make([]clusterRoute, 0, len(routes))
+	for _, route := range routes {
+		expanded = append(expanded, clusterRoute{
+			Server: route.Server.ValueString(),
+			Host:   route.Host.ValueString(),
+			Port:   int(route.Port.ValueInt64()),
+		})
+	}
+
+	return expanded
+}
+
+// flattenClusterRoutes sorts the routes so they are listed in a stable order.
+func flattenClusterRoutes(routes []clusterRoute) []ClusterRouteModel {
+	sorted := append([]clusterRoute(nil), routes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Server != sorted[j].Server {
+			return sorted[i].Server < sorted[j].Server
+		}
+		if sorted[i].Host != sorted[j].Host {
+			return sorted[i].Host < sorted[j].Host
+		}
+		return sorted[i].Port < sorted[j].Port
+	})
+
+	flattened := []ClusterRouteModel{}
+	for _, route := range sorted {
+		flattened = append(flattened, ClusterRouteModel{
+			Server: types.StringValue(route.Server),
+			Host:   types.StringValue(route.Host),
+			Port:   types.Int64Value(int64(route.Port)),
+		})
+	}
+
+	return flattened
+}
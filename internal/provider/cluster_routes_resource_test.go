@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterRoutesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterRoutesResourceConfig(`
+  route {
+    server = "hub"
+    host   = "10.0.0.2"
+    port   = 12345
+  }
+
+  route {
+    server = "leaf"
+    host   = "10.0.0.3"
+    port   = 12345
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_cluster_routes.test", "id", "routes"),
+					resource.TestCheckResourceAttr("harperdb_cluster_routes.test", "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("harperdb_cluster_routes.test", "route.*", map[string]string{
+						"server": "leaf",
+						"host":   "10.0.0.3",
+						"port":   "12345",
+					}),
+				),
+			},
+			{
+				ResourceName:      "harperdb_cluster_routes.test",
+				ImportState:       true,
+				ImportStateId:     "routes",
+				ImportStateVerify: true,
+			},
+			// Moving a route to the hub and removing the other one.
+			{
+				Config: testAccClusterRoutesResourceConfig(`
+  route {
+    server = "hub"
+    host   = "10.0.0.3"
+    port   = 12345
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_cluster_routes.test", "route.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("harperdb_cluster_routes.test", "route.*", map[string]string{
+						"server": "hub",
+						"host":   "10.0.0.3",
+					}),
+				),
+			},
+			{
+				Config: testAccClusterRoutesResourceConfig(`
+  route {
+    server = "hub"
+    host   = "10.0.0.3"
+    port   = 12345
+  }
+
+  route {
+    server = "leaf"
+    host   = "10.0.0.3"
+    port   = 12345
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Duplicate Route"),
+			},
+		},
+	})
+}
+
+func testAccClusterRoutesResourceConfig(routes string) string {
+	return fmt.Sprintf(`
+	%s
+
+resource "harperdb_cluster_routes" "test" {
+%s}
+`, testAccProviderTF(), routes)
+}
+
+func TestDiffClusterRoutes(t *testing.T) {
+	prior := []clusterRoute{
+		{Server: "hub", Host: "10.0.0.1", Port: 12345},
+		{Server: "hub", Host: "10.0.0.2", Port: 12345},
+		{Server: "leaf", Host: "10.0.0.3", Port: 12345},
+	}
+	planned := []clusterRoute{
+		{Server: "hub", Host: "10.0.0.1", Port: 12345},
+		{Server: "hub", Host: "10.0.0.3", Port: 12345},
+		{Server: "leaf", Host: "10.0.0.4", Port: 12345},
+	}
+
+	deleted, set := diffClusterRoutes(prior, planned)
+
+	wantDeleted := []harperdb.Route{
+		{Host: "10.0.0.2", Port: 12345},
+		{Host: "10.0.0.3", Port: 12345},
+	}
+	if !reflect.DeepEqual(deleted, wantDeleted) {
+		t.Errorf("expected deleted routes %v, got %v", wantDeleted, deleted)
+	}
+
+	wantSet := map[string][]harperdb.Route{
+		"hub":  {{Host: "10.0.0.3", Port: 12345}},
+		"leaf": {{Host: "10.0.0.4", Port: 12345}},
+	}
+	if !reflect.DeepEqual(set, wantSet) {
+		t.Errorf("expected set routes %v, got %v", wantSet, set)
+	}
+
+	deleted, set = diffClusterRoutes(planned, planned)
+	if len(deleted) != 0 || len(set) != 0 {
+		t.Errorf("expected no changes, got deleted %v and set %v", deleted, set)
+	}
+}
+
+func TestFlattenClusterRoutes(t *testing.T) {
+	routes := flattenClusterRoutes([]clusterRoute{
+		{Server: "leaf", Host: "10.0.0.1", Port: 12345},
+		{Server: "hub", Host: "10.0.0.2", Port: 12346},
+		{Server: "hub", Host: "10.0.0.2", Port: 12345},
+	})
+
+	var got []string
+	for _, route := range routes {
+		got = append(got, fmt.Sprintf("%s %s:%d", route.Server.ValueString(), route.Host.ValueString(), route.Port.ValueInt64()))
+	}
+
+	want := []string{"hub 10.0.0.2:12345", "hub 10.0.0.2:12346", "leaf 10.0.0.1:12345"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if routes := flattenClusterRoutes(nil); routes == nil || len(routes) != 0 {
+		t.Errorf("expected an empty, non-nil list, got %#v", routes)
+	}
+}
+
+func TestUnmanagedClusterRoutes(t *testing.T) {
+	current := []clusterRoute{
+		{Server: "leaf", Host: "10.0.0.3", Port: 12345},
+		{Server: "hub", Host: "10.0.0.1", Port: 12345},
+		{Server: "hub", Host: "10.0.0.2", Port: 12345},
+	}
+	planned := []clusterRoute{
+		{Server: "hub", Host: "10.0.0.1", Port: 12345},
+		{Server: "hub", Host: "10.0.0.3", Port: 12345},
+	}
+
+	want := []string{"hub 10.0.0.2:12345", "leaf 10.0.0.3:12345"}
+	if got := unmanagedClusterRoutes(current, planned); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected unmanaged routes %v, got %v", want, got)
+	}
+
+	if got := unmanagedClusterRoutes(planned, planned); len(got) != 0 {
+		t.Errorf("expected no unmanaged routes, got %v", got)
+	}
+}
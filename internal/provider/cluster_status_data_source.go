@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clusterConnectionOpen is the status of a connected node.
+const clusterConnectionOpen = "open"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClusterStatusDataSource{}
+
+func NewClusterStatusDataSource() datasource.DataSource {
+	return &ClusterStatusDataSource{}
+}
+
+// ClusterStatusDataSource defines the data source implementation.
+type ClusterStatusDataSource struct {
+	client *harperdb.Client
+}
+
+// ClusterStatusDataSourceModel describes the data source data model.
+type ClusterStatusDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	IncludeNetwork types.Bool   `tfsdk:"include_network"`
+	NodeName       types.String `tfsdk:"node_name"`
+	IsEnabled      types.Bool   `tfsdk:"is_enabled"`
+	AllConnected   types.Bool   `tfsdk:"all_connected"`
+	Connections    types.List   `tfsdk:"connections"`
+	Network        types.List   `tfsdk:"network"`
+}
+
+// ClusterStatusConnectionModel describes a single entry of connections.
+type ClusterStatusConnectionModel struct {
+	NodeName          types.String  `tfsdk:"node_name"`
+	Status            types.String  `tfsdk:"status"`
+	Connected         types.Bool    `tfsdk:"connected"`
+	LatencyMS         types.Float64 `tfsdk:"latency_ms"`
+	Uptime            types.String  `tfsdk:"uptime"`
+	ClusteringPort    types.Int64   `tfsdk:"clustering_port"`
+	OperationsAPIPort types.Int64   `tfsdk:"operations_api_port"`
+	HDBVersion        types.String  `tfsdk:"hdb_version"`
+	Subscriptions     types.List    `tfsdk:"subscriptions"`
+}
+
+// ClusterStatusSubscriptionModel describes a single subscription of a
+// connection.
+type ClusterStatusSubscriptionModel struct {
+	Schema    types.String `tfsdk:"schema"`
+	Table     types.String `tfsdk:"table"`
+	Publish   types.Bool   `tfsdk:"publish"`
+	Subscribe types.Bool   `tfsdk:"subscribe"`
+}
+
+// ClusterStatusNetworkNodeModel describes a single entry of network.
+type ClusterStatusNetworkNodeModel struct {
+	Name           types.String  `tfsdk:"name"`
+	ResponseTime   types.Float64 `tfsdk:"response_time"`
+	ConnectedNodes types.List    `tfsdk:"connected_nodes"`
+}
+
+var clusterStatusSubscriptionAttrTypes = map[string]attr.Type{
+	"schema":    types.StringType,
+	"table":     types.StringType,
+	"publish":   types.BoolType,
+	"subscribe": types.BoolType,
+}
+
+var clusterStatusConnectionAttrTypes = map[string]attr.Type{
+	"node_name":           types.StringType,
+	"status":              types.StringType,
+	"connected":           types.BoolType,
+	"latency_ms":          types.Float64Type,
+	"uptime":              types.StringType,
+	"clustering_port":     types.Int64Type,
+	"operations_api_port": types.Int64Type,
+	"hdb_version":         types.StringType,
+	"subscriptions":       types.ListType{ElemType: types.ObjectType{AttrTypes: clusterStatusSubscriptionAttrTypes}},
+}
+
+var clusterStatusNetworkNodeAttrTypes = map[string]attr.Type{
+	"name":            types.StringType,
+	"response_time":   types.Float64Type,
+	"connected_nodes": types.ListType{ElemType: types.StringType},
+}
+
+func (d *ClusterStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_status"
+}
+
+func (d *ClusterStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Clustering status of the instance and of its connections to other nodes, as reported by HarperDB 4.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Name of the node",
+				Computed:            true,
+			},
+			"include_network": schema.BoolAttribute{
+				MarkdownDescription: "Whether to query all nodes of the cluster with `cluster_network`, which waits for them to answer",
+				Optional:            true,
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the node, empty when clustering is not enabled",
+				Computed:            true,
+			},
+			"is_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether clustering is enabled",
+				Computed:            true,
+			},
+			"all_connected": schema.BoolAttribute{
+				MarkdownDescription: "Whether clustering is enabled and every connection is open",
+				Computed:            true,
+			},
+			"connections": schema.ListNestedAttribute{
+				MarkdownDescription: "Nodes the instance replicates with",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"node_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the node",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "State of the connection, for example `open` or `closed`",
+							Computed:            true,
+						},
+						"connected": schema.BoolAttribute{
+							MarkdownDescription: "Whether the connection is open",
+							Computed:            true,
+						},
+						"latency_ms": schema.Float64Attribute{
+							MarkdownDescription: "Latency to the node in milliseconds, null while not connected",
+							Computed:            true,
+						},
+						"uptime": schema.StringAttribute{
+							MarkdownDescription: "Uptime of the connection",
+							Computed:            true,
+						},
+						"clustering_port": schema.Int64Attribute{
+							MarkdownDescription: "Clustering port of the node",
+							Computed:            true,
+						},
+						"operations_api_port": schema.Int64Attribute{
+							MarkdownDescription: "Operations API port of the node",
+							Computed:            true,
+						},
+						"hdb_version": schema.StringAttribute{
+							MarkdownDescription: "HarperDB version of the node",
+							Computed:            true,
+						},
+						"subscriptions": schema.ListNestedAttribute{
+							MarkdownDescription: "Tables replicated with the node",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"schema":    schema.StringAttribute{MarkdownDescription: "Schema of the table", Computed: true},
+									"table":     schema.StringAttribute{MarkdownDescription: "Name of the table", Computed: true},
+									"publish":   schema.BoolAttribute{MarkdownDescription: "Whether changes are sent to the node", Computed: true},
+									"subscribe": schema.BoolAttribute{MarkdownDescription: "Whether changes are received from the node", Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			"network": schema.ListNestedAttribute{
+				MarkdownDescription: "Nodes of the cluster which answered `cluster_network`, null unless `include_network` is set",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the node",
+							Computed:            true,
+						},
+						"response_time": schema.Float64Attribute{
+							MarkdownDescription: "Time the node took to answer in milliseconds",
+							Computed:            true,
+						},
+						"connected_nodes": schema.ListAttribute{
+							MarkdownDescription: "Names of the nodes it is connected to",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClusterStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClusterStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClusterStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var status clusterStatusResponse
+	if err := d.client.RawRequest(opClusterStatus{}, &status); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster status, got error: %s", err))
+		return
+	}
+
+	var network *clusterNetworkResponse
+	if data.IncludeNetwork.ValueBool() && status.IsEnabled {
+		network = &clusterNetworkResponse{}
+		if err := d.client.RawRequest(opClusterNetwork{ConnectedNodes: true}, network); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster network, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(flattenClusterStatus(ctx, &status, network, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenClusterStatus sets the computed attributes of data. network is nil
+// unless it was requested.
+func flattenClusterStatus(ctx context.Context, status *clusterStatusResponse, network *clusterNetworkResponse, data *ClusterStatusDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The node name is a number when clustering is disabled.
+	nodeName, _ := status.NodeName.(string)
+
+	data.ID = types.StringValue(nodeName)
+	data.NodeName = types.StringValue(nodeName)
+	data.IsEnabled = types.BoolValue(status.IsEnabled)
+
+	allConnected := status.IsEnabled
+	connections := make([]ClusterStatusConnectionModel, 0, len(status.Connections))
+	for _, connection := range status.Connections {
+		subscriptions := make([]ClusterStatusSubscriptionModel, 0, len(connection.Subscriptions))
+		for _, subscription := range connection.Subscriptions {
+			subscriptions = append(subscriptions, ClusterStatusSubscriptionModel{
+				Schema:    types.StringValue(subscription.Schema),
+				Table:     types.StringValue(subscription.Table),
+				Publish:   types.BoolValue(subscription.Publish),
+				Subscribe: types.BoolValue(subscription.Subscribe),
+			})
+		}
+
+		subscriptionsValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: clusterStatusSubscriptionAttrTypes}, subscriptions)
+		diags.Append(d...)
+
+		connected := connection.Status == clusterConnectionOpen
+		allConnected = allConnected && connected
+
+		connections = append(connections, ClusterStatusConnectionModel{
+			NodeName:          types.StringValue(connection.NodeName),
+			Status:            types.StringValue(connection.Status),
+			Connected:         types.BoolValue(connected),
+			LatencyMS:         types.Float64PointerValue(connection.LatencyMS),
+			Uptime:            types.StringValue(connection.Uptime),
+			ClusteringPort:    types.Int64PointerValue(connection.Ports.Clustering),
+			OperationsAPIPort: types.Int64PointerValue(connection.Ports.OperationsAPI),
+			HDBVersion:        types.StringValue(connection.SystemInfo.HDBVersion),
+			Subscriptions:     subscriptionsValue,
+		})
+	}
+
+	data.AllConnected = types.BoolValue(allConnected)
+
+	var d diag.Diagnostics
+	data.Connections, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: clusterStatusConnectionAttrTypes}, connections)
+	diags.Append(d...)
+
+	data.Network = types.ListNull(types.ObjectType{AttrTypes: clusterStatusNetworkNodeAttrTypes})
+	if network != nil {
+		nodes := make([]ClusterStatusNetworkNodeModel, 0, len(network.Nodes))
+		for _, node := range network.Nodes {
+			// Nodes without connections omit connected_nodes.
+			connectedNodes, d := types.ListValueFrom(ctx, types.StringType, append([]string{}, node.ConnectedNodes...))
+			diags.Append(d...)
+
+			nodes = append(nodes, ClusterStatusNetworkNodeModel{
+				Name:           types.StringValue(node.Name),
+				ResponseTime:   types.Float64Value(node.ResponseTime),
+				ConnectedNodes: connectedNodes,
+			})
+		}
+
+		data.Network, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: clusterStatusNetworkNodeAttrTypes}, nodes)
+		diags.Append(d...)
+	}
+
+	return diags
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_cluster_status" "test" {}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.harperdb_cluster_status.test", "is_enabled"),
+					resource.TestCheckResourceAttrSet("data.harperdb_cluster_status.test", "all_connected"),
+					resource.TestCheckResourceAttrSet("data.harperdb_cluster_status.test", "connections.#"),
+					resource.TestCheckNoResourceAttr("data.harperdb_cluster_status.test", "network"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenClusterStatus(t *testing.T) {
+	var status clusterStatusResponse
+	err := json.Unmarshal([]byte(`{
+		"node_name": "node-1",
+		"is_enabled": true,
+		"connections": [{
+			"node_name": "node-2",
+			"status": "open",
+			"ports": {"clustering": 12345, "operations_api": 9925},
+			"latency_ms": 13,
+			"uptime": "30d 1h 18m 8s",
+			"subscriptions": [{"schema": "dev", "table": "dog", "publish": true, "subscribe": true}],
+			"system_info": {"hdb_version": "4.1.0", "node_version": "18.15.0", "platform": "linux"}
+		}, {
+			"node_name": "node-3",
+			"status": "closed",
+			"ports": {},
+			"subscriptions": []
+		}]
+	}`), &status)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var network clusterNetworkResponse
+	err = json.Unmarshal([]byte(`{
+		"nodes": [{"name": "node-1", "response_time": 2, "connected_nodes": ["node-2"]}, {"name": "node-2", "response_time": 15}]
+	}`), &network)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var data ClusterStatusDataSourceModel
+	if diags := flattenClusterStatus(context.Background(), &status, &network, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if data.ID.ValueString() != "node-1" || !data.IsEnabled.ValueBool() {
+		t.Errorf("unexpected node: %s, enabled %s", data.ID, data.IsEnabled)
+	}
+
+	if data.AllConnected.ValueBool() {
+		t.Error("expected all_connected to be false with a closed connection")
+	}
+
+	var connections []ClusterStatusConnectionModel
+	if diags := data.Connections.ElementsAs(context.Background(), &connections, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(connections) != 2 {
+		t.Fatalf("expected 2 connections, got %d", len(connections))
+	}
+
+	open := connections[0]
+	if !open.Connected.ValueBool() || open.LatencyMS.ValueFloat64() != 13 || open.ClusteringPort.ValueInt64() != 12345 || open.HDBVersion.ValueString() != "4.1.0" {
+		t.Errorf("unexpected open connection: %+v", open)
+	}
+
+	if len(open.Subscriptions.Elements()) != 1 {
+		t.Errorf("expected 1 subscription, got %s", open.Subscriptions)
+	}
+
+	closed := connections[1]
+	if closed.Connected.ValueBool() || !closed.LatencyMS.IsNull() || !closed.ClusteringPort.IsNull() {
+		t.Errorf("expected a closed connection without latency and ports, got %+v", closed)
+	}
+
+	var nodes []ClusterStatusNetworkNodeModel
+	if diags := data.Network.ElementsAs(context.Background(), &nodes, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(nodes) != 2 || nodes[0].Name.ValueString() != "node-1" || len(nodes[0].ConnectedNodes.Elements()) != 1 {
+		t.Errorf("unexpected network: %s", data.Network)
+	}
+
+	if nodes[1].ConnectedNodes.IsNull() || len(nodes[1].ConnectedNodes.Elements()) != 0 {
+		t.Errorf("expected empty connected_nodes, got %s", nodes[1].ConnectedNodes)
+	}
+}
+
+func TestFlattenClusterStatus_disabled(t *testing.T) {
+	var status clusterStatusResponse
+	if err := json.Unmarshal([]byte(`{"node_name": 1, "is_enabled": false}`), &status); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var data ClusterStatusDataSourceModel
+	if diags := flattenClusterStatus(context.Background(), &status, nil, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if data.NodeName.ValueString() != "" || data.IsEnabled.ValueBool() || data.AllConnected.ValueBool() {
+		t.Errorf("unexpected status for disabled clustering: %+v", data)
+	}
+
+	if data.Connections.IsNull() || len(data.Connections.Elements()) != 0 {
+		t.Errorf("expected empty connections, got %s", data.Connections)
+	}
+
+	if !data.Network.Equal(types.ListNull(data.Network.ElementType(context.Background()))) {
+		t.Errorf("expected a null network, got %s", data.Network)
+	}
+}
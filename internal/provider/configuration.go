@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+)
+
+// getConfiguration returns the configuration of the instance as nested maps.
+// Numbers are decoded as json.Number to retain them exactly.
+func getConfiguration(client *harperdb.Client) (map[string]interface{}, error) {
+	var raw json.RawMessage
+	if err := client.RawRequest(opGetConfiguration{}, &raw); err != nil {
+		return nil, err
+	}
+
+	return decodeConfiguration(raw)
+}
+
+// decodeConfiguration decodes a get_configuration response.
+func decodeConfiguration(raw []byte) (map[string]interface{}, error) {
+	var config map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// flattenConfiguration returns the leaves of config keyed by their path, with
+// the keys of nested objects separated by ".". Arrays are leaves.
+func flattenConfiguration(config map[string]interface{}) map[string]interface{} {
+	leaves := map[string]interface{}{}
+
+	var flatten func(prefix string, value interface{})
+	flatten = func(prefix string, value interface{}) {
+		object, ok := value.(map[string]interface{})
+		if !ok || len(object) == 0 {
+			leaves[prefix] = value
+			return
+		}
+
+		for key, nested := range object {
+			flatten(prefix+"."+key, nested)
+		}
+	}
+
+	for key, value := range config {
+		flatten(key, value)
+	}
+
+	return leaves
+}
+
+// configurationValue returns the value at the "." separated path of config.
+func configurationValue(config map[string]interface{}, key string) (interface{}, bool) {
+	var value interface{} = config
+	for _, part := range strings.Split(key, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = object[part]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// setConfigurationKey converts a configuration path into the parameter name
+// of set_configuration, for example logging.level into logging_level.
+func setConfigurationKey(key string) string {
+	return strings.ReplaceAll(key, ".", "_")
+}
+
+// configurationSetting converts a setting into the value sent to
+// set_configuration. Numbers, booleans, null, arrays and objects are sent in
+// their JSON form, anything else as string. This is the inverse of
+// jsonValueString.
+func configurationSetting(value string) interface{} {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+
+	if _, ok := decoded.(string); ok {
+		return value
+	}
+
+	return json.RawMessage(value)
+}
+
+// normalizeConfigurationValue re-encodes settings holding JSON arrays or
+// objects compactly with sorted keys, so that values differing in formatting
+// only compare equal. Other values are returned as-is.
+func normalizeConfigurationValue(value string) string {
+	raw, ok := configurationSetting(value).(json.RawMessage)
+	if !ok {
+		return value
+	}
+
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return value
+	}
+
+	b, err := json.Marshal(decoded)
+	if err != nil {
+		return value
+	}
+
+	return string(b)
+}
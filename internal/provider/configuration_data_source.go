@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ConfigurationDataSource{}
+
+func NewConfigurationDataSource() datasource.DataSource {
+	return &ConfigurationDataSource{}
+}
+
+// ConfigurationDataSource defines the data source implementation.
+type ConfigurationDataSource struct {
+	client *harperdb.Client
+}
+
+// ConfigurationDataSourceModel describes the data source data model.
+type ConfigurationDataSourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	JSON                    types.String `tfsdk:"json"`
+	Values                  types.Map    `tfsdk:"values"`
+	HTTPPort                types.Int64  `tfsdk:"http_port"`
+	HTTPSecurePort          types.Int64  `tfsdk:"http_secure_port"`
+	OperationsAPIPort       types.Int64  `tfsdk:"operations_api_port"`
+	OperationsAPISecurePort types.Int64  `tfsdk:"operations_api_secure_port"`
+	ClusteringEnabled       types.Bool   `tfsdk:"clustering_enabled"`
+	ClusteringNodeName      types.String `tfsdk:"clustering_node_name"`
+	LoggingLevel            types.String `tfsdk:"logging_level"`
+}
+
+func (d *ConfigurationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configuration"
+}
+
+func (d *ConfigurationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reads the configuration of the instance. The typed attributes are null when the key is not configured.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Hash of the configuration",
+				Computed:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Configuration as JSON with sorted keys",
+				Computed:            true,
+			},
+			"values": schema.MapAttribute{
+				MarkdownDescription: "Every configured value keyed by its path, for example `logging.level`. " +
+					"Strings are returned as-is, numbers, booleans and arrays in their JSON representation.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"http_port": schema.Int64Attribute{
+				MarkdownDescription: "`http.port`",
+				Computed:            true,
+			},
+			"http_secure_port": schema.Int64Attribute{
+				MarkdownDescription: "`http.securePort`",
+				Computed:            true,
+			},
+			"operations_api_port": schema.Int64Attribute{
+				MarkdownDescription: "`operationsApi.network.port`",
+				Computed:            true,
+			},
+			"operations_api_secure_port": schema.Int64Attribute{
+				MarkdownDescription: "`operationsApi.network.securePort`",
+				Computed:            true,
+			},
+			"clustering_enabled": schema.BoolAttribute{
+				MarkdownDescription: "`clustering.enabled`",
+				Computed:            true,
+			},
+			"clustering_node_name": schema.StringAttribute{
+				MarkdownDescription: "`clustering.nodeName`",
+				Computed:            true,
+			},
+			"logging_level": schema.StringAttribute{
+				MarkdownDescription: "`logging.level`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConfigurationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConfigurationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ConfigurationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := getConfiguration(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(flattenConfigurationDataSource(config, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenConfigurationDataSource sets the computed attributes of data.
+func flattenConfigurationDataSource(config map[string]interface{}, data *ConfigurationDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	b, err := json.Marshal(config)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to encode configuration, got error: %s", err))
+		return diags
+	}
+
+	values := map[string]attr.Value{}
+	for key, value := range flattenConfiguration(config) {
+		if value == nil {
+			values[key] = types.StringNull()
+			continue
+		}
+		values[key] = types.StringValue(jsonValueString(value))
+	}
+
+	valuesValue, d := types.MapValue(types.StringType, values)
+	diags.Append(d...)
+
+	data.ID = types.StringValue(fmt.Sprintf("%x", sha256.Sum256(b)))
+	data.JSON = types.StringValue(string(b))
+	data.Values = valuesValue
+	data.HTTPPort = configurationInt64(config, "http.port")
+	data.HTTPSecurePort = configurationInt64(config, "http.securePort")
+	data.OperationsAPIPort = configurationInt64(config, "operationsApi.network.port")
+	data.OperationsAPISecurePort = configurationInt64(config, "operationsApi.network.securePort")
+	data.ClusteringEnabled = configurationBool(config, "clustering.enabled")
+	data.ClusteringNodeName = configurationStringValue(config, "clustering.nodeName")
+	data.LoggingLevel = configurationStringValue(config, "logging.level")
+
+	return diags
+}
+
+// configurationInt64 returns the integer at key, or null when the key is
+// missing or not an integer.
+func configurationInt64(config map[string]interface{}, key string) types.Int64 {
+	value, _ := configurationValue(config, key)
+	if n, ok := value.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return types.Int64Value(i)
+		}
+	}
+
+	return types.Int64Null()
+}
+
+// configurationBool returns the boolean at key, or null when the key is
+// missing or not a boolean.
+func configurationBool(config map[string]interface{}, key string) types.Bool {
+	value, _ := configurationValue(config, key)
+	if b, ok := value.(bool); ok {
+		return types.BoolValue(b)
+	}
+
+	return types.BoolNull()
+}
+
+// configurationStringValue returns the string at key, or null when the key
+// is missing or not a string.
+func configurationStringValue(config map[string]interface{}, key string) types.String {
+	value, _ := configurationValue(config, key)
+	if s, ok := value.(string); ok {
+		return types.StringValue(s)
+	}
+
+	return types.StringNull()
+}
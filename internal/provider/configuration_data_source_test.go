@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigurationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_configuration" "test" {}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.harperdb_configuration.test", "json", regexp.MustCompile(`^\{`)),
+					resource.TestCheckResourceAttrSet("data.harperdb_configuration.test", "logging_level"),
+					resource.TestCheckResourceAttrPair("data.harperdb_configuration.test", "logging_level", "data.harperdb_configuration.test", "values.logging.level"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenConfigurationDataSource(t *testing.T) {
+	config, err := decodeConfiguration([]byte(`{
+		"http": {"port": 9926, "securePort": null},
+		"operationsApi": {"network": {"port": 9925}},
+		"clustering": {"enabled": false, "nodeName": "node-1"},
+		"logging": {"level": "warn"}
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var data ConfigurationDataSourceModel
+	if diags := flattenConfigurationDataSource(config, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if data.HTTPPort.ValueInt64() != 9926 || data.OperationsAPIPort.ValueInt64() != 9925 {
+		t.Errorf("unexpected ports %s and %s", data.HTTPPort, data.OperationsAPIPort)
+	}
+
+	if !data.HTTPSecurePort.IsNull() || !data.OperationsAPISecurePort.IsNull() {
+		t.Errorf("expected unset secure ports to be null, got %s and %s", data.HTTPSecurePort, data.OperationsAPISecurePort)
+	}
+
+	if data.ClusteringEnabled.IsNull() || data.ClusteringEnabled.ValueBool() || data.ClusteringNodeName.ValueString() != "node-1" {
+		t.Errorf("unexpected clustering %s %s", data.ClusteringEnabled, data.ClusteringNodeName)
+	}
+
+	if data.LoggingLevel.ValueString() != "warn" {
+		t.Errorf("expected logging level warn, got %s", data.LoggingLevel)
+	}
+
+	want := `{"clustering":{"enabled":false,"nodeName":"node-1"},"http":{"port":9926,"securePort":null},"logging":{"level":"warn"},"operationsApi":{"network":{"port":9925}}}`
+	if data.JSON.ValueString() != want {
+		t.Errorf("expected json %s, got %s", want, data.JSON)
+	}
+
+	if got := data.Values.Elements()["clustering.enabled"].String(); got != `"false"` {
+		t.Errorf("expected clustering.enabled false, got %s", got)
+	}
+}
@@ -0,0 +1,468 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// configurationOriginalsKey is the private state key of the values the
+// settings had before they were first managed, encoded as JSON.
+const configurationOriginalsKey = "originals"
+
+// configurationLiveSections are the configuration sections HarperDB applies
+// without a restart.
+var configurationLiveSections = []string{"logging"}
+
+// configurationKeyRegexp matches configuration paths such as
+// operationsApi.network.port.
+var configurationKeyRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(\.[A-Za-z][A-Za-z0-9]*)*$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConfigurationResource{}
+var _ resource.ResourceWithImportState = &ConfigurationResource{}
+
+func NewConfigurationResource() resource.Resource {
+	return &ConfigurationResource{}
+}
+
+// ConfigurationResource defines the resource implementation.
+// It manages the declared settings only, all other settings are left alone.
+type ConfigurationResource struct {
+	client *harperdb.Client
+}
+
+// ConfigurationResourceModel describes the resource data model.
+type ConfigurationResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Settings         types.Map    `tfsdk:"settings"`
+	Restart          types.String `tfsdk:"restart"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func (r *ConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configuration"
+}
+
+func (r *ConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages settings of the instance configuration. Settings which are not declared are left untouched. " +
+			"HarperDB applies most changed settings only after a restart, see `restart`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Always `configuration`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"settings": schema.MapAttribute{
+				MarkdownDescription: "Settings keyed by their path, for example `logging.level`, as in `values` of the `harperdb_configuration` data source. " +
+					"Values which are valid JSON numbers, booleans, arrays or objects are set in their JSON form, all other values as strings.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(configurationKeyRegexp, "must be a configuration path such as logging.level")),
+				},
+			},
+			"restart": schema.StringAttribute{
+				MarkdownDescription: "Restart after settings changed: `instance` restarts HarperDB, `" + strings.Join(restartServices, "`, `") + "` " +
+					"restart only that service. The restart is skipped when only settings HarperDB applies live changed, " +
+					"which are the ones under `" + strings.Join(configurationLiveSections, "`, `") + "`. " +
+					"The apply waits until the instance is healthy again. By default nothing is restarted. " +
+					"Use a `harperdb_restart` to restart once for several changes.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(append([]string{restartInstance}, restartServices...)...),
+				},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Restore the values settings had before they were managed when the resource is destroyed or the settings are removed from `settings`. " +
+					"Imported settings cannot be restored.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := map[string]string{}
+	resp.Diagnostics.Append(data.Settings.ElementsAs(ctx, &settings, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	originals := map[string]json.RawMessage{}
+	if err := r.recordOriginals(originals, settings); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration, got error: %s", err))
+		return
+	}
+
+	if err := r.apply(ctx, data.Restart, configurationSettings(settings)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set configuration, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("configuration")
+
+	tflog.Trace(ctx, "created a configuration resource")
+
+	resp.Diagnostics.Append(setConfigurationOriginals(ctx, resp.Private, originals)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := getConfiguration(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration, got error: %s", err))
+		return
+	}
+
+	prior := map[string]types.String{}
+	resp.Diagnostics.Append(data.Settings.ElementsAs(ctx, &prior, false)...)
+
+	// Settings removed from the configuration file are dropped, so the next
+	// plan sets them again. Values equal to the prior ones apart from their
+	// formatting keep the prior form.
+	settings := map[string]attr.Value{}
+	for key, priorValue := range prior {
+		value, ok := configurationValue(config, key)
+		if !ok {
+			continue
+		}
+
+		current := jsonValueString(value)
+		if !priorValue.IsNull() && normalizeConfigurationValue(priorValue.ValueString()) == normalizeConfigurationValue(current) {
+			current = priorValue.ValueString()
+		}
+		settings[key] = types.StringValue(current)
+	}
+
+	settingsValue, diags := types.MapValue(types.StringType, settings)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Settings = settingsValue
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ConfigurationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, prior := map[string]string{}, map[string]string{}
+	resp.Diagnostics.Append(data.Settings.ElementsAs(ctx, &settings, false)...)
+	resp.Diagnostics.Append(state.Settings.ElementsAs(ctx, &prior, false)...)
+	originals, diags := getConfigurationOriginals(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added := map[string]string{}
+	changed := opSetConfiguration{}
+	for key, value := range settings {
+		priorValue, ok := prior[key]
+		if !ok {
+			added[key] = value
+		}
+		if !ok || priorValue != value {
+			changed[setConfigurationKey(key)] = configurationSetting(value)
+		}
+	}
+
+	if err := r.recordOriginals(originals, added); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configuration, got error: %s", err))
+		return
+	}
+
+	// Settings which are no longer managed are restored like on destroy.
+	for key := range prior {
+		if _, ok := settings[key]; ok {
+			continue
+		}
+
+		if original, ok := originals[key]; ok && data.RestoreOnDestroy.ValueBool() {
+			changed[setConfigurationKey(key)] = original
+		}
+		delete(originals, key)
+	}
+
+	if err := r.apply(ctx, data.Restart, changed); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set configuration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setConfigurationOriginals(ctx, resp.Private, originals)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ConfigurationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	originals, diags := getConfigurationOriginals(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restored := opSetConfiguration{}
+	for key := range data.Settings.Elements() {
+		if original, ok := originals[key]; ok {
+			restored[setConfigurationKey(key)] = original
+		}
+	}
+
+	if err := r.apply(ctx, data.Restart, restored); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore configuration, got error: %s", err))
+		return
+	}
+}
+
+// ImportState imports the settings given as comma separated paths, for
+// example logging.level,http.port.
+func (r *ConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	settings := map[string]attr.Value{}
+	for _, key := range strings.Split(req.ID, ",") {
+		key = strings.TrimSpace(key)
+		if !configurationKeyRegexp.MatchString(key) {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: path,path,... such as logging.level,http.port. Got: %q", req.ID),
+			)
+			return
+		}
+
+		// The values are read by Read.
+		settings[key] = types.StringNull()
+	}
+
+	settingsValue, diags := types.MapValue(types.StringType, settings)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "configuration")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("settings"), settingsValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restore_on_destroy"), false)...)
+}
+
+// recordOriginals adds the current values of settings missing from originals.
+// Settings which are not configured at all cannot be restored and are
+// skipped.
+func (r *ConfigurationResource) recordOriginals(originals map[string]json.RawMessage, settings map[string]string) error {
+	if len(settings) == 0 {
+		return nil
+	}
+
+	config, err := getConfiguration(r.client)
+	if err != nil {
+		return err
+	}
+
+	for key := range settings {
+		if _, ok := originals[key]; ok {
+			continue
+		}
+
+		value, ok := configurationValue(config, key)
+		if !ok {
+			continue
+		}
+
+		original, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		originals[key] = original
+	}
+
+	return nil
+}
+
+// apply sets the settings and restarts when requested. Nothing happens
+// without settings.
+func (r *ConfigurationResource) apply(ctx context.Context, target types.String, settings opSetConfiguration) error {
+	if len(settings) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	tflog.Debug(ctx, "setting configuration", map[string]interface{}{"keys": keys})
+
+	if err := r.client.RawRequest(settings, nil); err != nil {
+		return err
+	}
+
+	if target.IsNull() {
+		return nil
+	}
+
+	if !requiresRestart(settings) {
+		tflog.Debug(ctx, "configuration applied without restart", map[string]interface{}{"keys": keys})
+		return nil
+	}
+
+	return restart(ctx, r.client, target.ValueString(), defaultRestartTimeout)
+}
+
+// requiresRestart reports whether any of the set_configuration parameters
+// only takes effect after a restart, which is all but the ones of
+// configurationLiveSections.
+func requiresRestart(settings opSetConfiguration) bool {
+	for key := range settings {
+		live := false
+		for _, section := range configurationLiveSections {
+			if key == section || strings.HasPrefix(key, setConfigurationKey(section+".")) {
+				live = true
+				break
+			}
+		}
+
+		if !live {
+			return true
+		}
+	}
+
+	return false
+}
+
+// configurationSettings converts settings into set_configuration parameters.
+func configurationSettings(settings map[string]string) opSetConfiguration {
+	op := make(opSetConfiguration, len(settings))
+	for key, value := range settings {
+		op[setConfigurationKey(key)] = configurationSetting(value)
+	}
+
+	return op
+}
+
+// privateState is implemented by the private state of requests and
+// responses, whose type is internal to the framework.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getConfigurationOriginals returns the originals stored in the private state.
+func getConfigurationOriginals(ctx context.Context, private privateState) (map[string]json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	originals := map[string]json.RawMessage{}
+
+	b, d := private.GetKey(ctx, configurationOriginalsKey)
+	diags.Append(d...)
+	if len(b) == 0 {
+		return originals, diags
+	}
+
+	if err := json.Unmarshal(b, &originals); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to decode original settings, got error: %s", err))
+	}
+
+	return originals, diags
+}
+
+// setConfigurationOriginals stores the originals in the private state.
+func setConfigurationOriginals(ctx context.Context, private privateState, originals map[string]json.RawMessage) diag.Diagnostics {
+	b, err := json.Marshal(originals)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Client Error", fmt.Sprintf("Unable to encode original settings, got error: %s", err))
+		return diags
+	}
+
+	return private.SetKey(ctx, configurationOriginalsKey, b)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConfigurationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationResourceConfig("warn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_configuration.test", "id", "configuration"),
+					resource.TestCheckResourceAttr("harperdb_configuration.test", "settings.logging.level", "warn"),
+					resource.TestCheckResourceAttr("harperdb_configuration.test", "settings.logging.stdStreams", "false"),
+				),
+			},
+			{
+				ResourceName:            "harperdb_configuration.test",
+				ImportState:             true,
+				ImportStateId:           "logging.level,logging.stdStreams",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restore_on_destroy"},
+			},
+			{
+				Config: testAccConfigurationResourceConfig("error"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_configuration.test", "settings.logging.level", "error"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationResourceConfig(level string) string {
+	return fmt.Sprintf(`
+	%s
+
+resource "harperdb_configuration" "test" {
+  settings = {
+    "logging.level"      = %q
+    "logging.stdStreams" = "false"
+  }
+  restore_on_destroy = true
+}
+`, testAccProviderTF(), level)
+}
+
+func TestConfigurationSettings(t *testing.T) {
+	got := configurationSettings(map[string]string{
+		"logging.level":              "warn",
+		"operationsApi.network.port": "9925",
+		"clustering.enabled":         "true",
+		"http.corsAccessList":        `["a.example.com"]`,
+		"clustering.nodeName":        "node-1",
+		"logging.file":               `"quoted"`,
+	})
+
+	want := opSetConfiguration{
+		"logging_level":              "warn",
+		"operationsApi_network_port": json.RawMessage("9925"),
+		"clustering_enabled":         json.RawMessage("true"),
+		"http_corsAccessList":        json.RawMessage(`["a.example.com"]`),
+		"clustering_nodeName":        "node-1",
+		"logging_file":               `"quoted"`,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	b, err := json.Marshal(got.Prepare())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wantJSON := `{"clustering_enabled":true,"clustering_nodeName":"node-1","http_corsAccessList":["a.example.com"],` +
+		`"logging_file":"\"quoted\"","logging_level":"warn","operation":"set_configuration","operationsApi_network_port":9925}`
+	if string(b) != wantJSON {
+		t.Errorf("expected %s, got %s", wantJSON, b)
+	}
+}
+
+func TestRequiresRestart(t *testing.T) {
+	testCases := map[string]struct {
+		settings map[string]string
+		want     bool
+	}{
+		"live": {
+			settings: map[string]string{"logging.level": "warn", "logging.stdStreams": "false"},
+		},
+		"restart": {
+			settings: map[string]string{"logging.level": "warn", "http.threads": "4"},
+			want:     true,
+		},
+		"prefix": {
+			settings: map[string]string{"loggingRoot.path": "/tmp"},
+			want:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			if got := requiresRestart(configurationSettings(tc.settings)); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeConfigurationValue(t *testing.T) {
+	testCases := map[string]string{
+		"warn":                       "warn",
+		"9925":                       "9925",
+		`[ "a.example.com" ]`:        `["a.example.com"]`,
+		`{"b": 1, "a": {"d": 2.50}}`: `{"a":{"d":2.50},"b":1}`,
+		`"quoted"`:                   `"quoted"`,
+	}
+
+	for value, want := range testCases {
+		if got := normalizeConfigurationValue(value); got != want {
+			t.Errorf("%q: expected %s, got %s", value, want, got)
+		}
+	}
+}
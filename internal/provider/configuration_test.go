@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFlattenConfiguration(t *testing.T) {
+	config, err := decodeConfiguration([]byte(`{
+		"http": {"port": 9926, "cors": true, "corsAccessList": [null]},
+		"logging": {"level": "warn", "rotation": {}},
+		"rootPath": "/hdb",
+		"componentsRoot": null
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"http.port":           json.Number("9926"),
+		"http.cors":           true,
+		"http.corsAccessList": []interface{}{nil},
+		"logging.level":       "warn",
+		"logging.rotation":    map[string]interface{}{},
+		"rootPath":            "/hdb",
+		"componentsRoot":      nil,
+	}
+	if got := flattenConfiguration(config); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if value, ok := configurationValue(config, "logging.level"); !ok || value != "warn" {
+		t.Errorf("expected logging.level warn, got %v", value)
+	}
+
+	for _, key := range []string{"logging.file", "rootPath.nested", "http.port.value"} {
+		if value, ok := configurationValue(config, key); ok {
+			t.Errorf("expected %s to be missing, got %v", key, value)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InstanceReadyDataSource{}
+
+func NewInstanceReadyDataSource() datasource.DataSource {
+	return &InstanceReadyDataSource{}
+}
+
+// InstanceReadyDataSource defines the data source implementation.
+type InstanceReadyDataSource struct {
+	client *harperdb.Client
+}
+
+// InstanceReadyDataSourceModel describes the data source data model.
+type InstanceReadyDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Timeout types.String `tfsdk:"timeout"`
+	Version types.String `tfsdk:"version"`
+}
+
+func (d *InstanceReadyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_ready"
+}
+
+func (d *InstanceReadyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Waits until the Operations API of the instance responds and accepts the credentials of the provider, " +
+			"retrying with exponential backoff. Data sources may be read while planning, so instances provisioned in the same apply " +
+			"should use the `harperdb_instance_ready` resource instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Same as `version`",
+				Computed:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long to wait, for example `15m`. Defaults to `%s`.", defaultReadyTimeout),
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "HarperDB version of the instance",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *InstanceReadyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InstanceReadyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceReadyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultReadyTimeout
+	if !data.Timeout.IsNull() {
+		// The value has been validated by durationValidator.
+		timeout, _ = time.ParseDuration(data.Timeout.ValueString())
+	}
+
+	version, err := waitForReady(ctx, d.client, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Instance not ready, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(version)
+	data.Version = types.StringValue(version)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInstanceReadyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_instance_ready" "test" {
+  timeout = "2m"
+}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.harperdb_instance_ready.test", "version", regexp.MustCompile(`^\d+\.\d+`)),
+					resource.TestCheckResourceAttrPair("data.harperdb_instance_ready.test", "id", "data.harperdb_instance_ready.test", "version"),
+				),
+			},
+		},
+	})
+}
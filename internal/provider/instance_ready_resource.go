@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultReadyTimeout is how long to wait for an instance to become ready.
+const defaultReadyTimeout = 10 * time.Minute
+
+// Bounds of the exponential backoff between readiness checks. They are
+// variables to speed up tests.
+var (
+	readyMinBackoff = time.Second
+	readyMaxBackoff = 30 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InstanceReadyResource{}
+
+func NewInstanceReadyResource() resource.Resource {
+	return &InstanceReadyResource{}
+}
+
+// InstanceReadyResource defines the resource implementation.
+// It waits for the instance when it is created and exists in the Terraform
+// state only.
+type InstanceReadyResource struct {
+	client *harperdb.Client
+}
+
+// InstanceReadyResourceModel describes the resource data model.
+type InstanceReadyResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Timeout  types.String `tfsdk:"timeout"`
+	Version  types.String `tfsdk:"version"`
+}
+
+func (r *InstanceReadyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_ready"
+}
+
+func (r *InstanceReadyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Waits until the Operations API of the instance responds and accepts the credentials of the provider, " +
+			"retrying with exponential backoff. Resources depending on it are not created before the instance is ready, " +
+			"which makes it suitable for instances provisioned in the same apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Time the instance was ready",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values whose change causes waiting again, for example the ID of the instance",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long to wait, for example `15m`. Defaults to `%s`.", defaultReadyTimeout),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultReadyTimeout.String()),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "HarperDB version of the instance",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InstanceReadyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InstanceReadyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InstanceReadyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The value has been validated by durationValidator.
+	timeout, _ := time.ParseDuration(data.Timeout.ValueString())
+
+	version, err := waitForReady(ctx, r.client, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Instance not ready, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	data.Version = types.StringValue(version)
+
+	tflog.Trace(ctx, "instance ready", map[string]interface{}{"version": version})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceReadyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InstanceReadyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Refresh the version, for example after an upgrade. An unreachable
+	// instance keeps the recorded version, waiting is up to Create.
+	var info systemInformationResponse
+	err := r.client.RawRequest(opSystemInformation{Attributes: []string{"system"}}, &info)
+	if err != nil {
+		tflog.Warn(ctx, "unable to refresh the instance version", map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	if info.System != nil {
+		data.Version = types.StringValue(info.System.HDBVersion)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceReadyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InstanceReadyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timeout can change in place, it applies to the next wait.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceReadyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing the resource from the state does not affect the instance.
+}
+
+// waitForReady polls system_information with exponential backoff until it
+// succeeds or timeout expires, and returns the HarperDB version. Failing
+// authentication is retried as well, since the credentials may not have been
+// set up yet while the instance boots.
+func waitForReady(ctx context.Context, client *harperdb.Client, timeout time.Duration) (string, error) {
+	info, err := pollSystemInformation(ctx, client, timeout, readyBackoff)
+	if err != nil {
+		return "", err
+	}
+
+	if info.System == nil {
+		return "", nil
+	}
+
+	return info.System.HDBVersion, nil
+}
+
+// readyBackoff returns the delay before the given attempt of waitForReady.
+// The first attempt is immediate.
+func readyBackoff(attempt int) time.Duration {
+	if attempt == 0 {
+		return 0
+	}
+
+	backoff := readyMinBackoff
+	for i := 1; i < attempt && backoff < readyMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > readyMaxBackoff {
+		return readyMaxBackoff
+	}
+
+	return backoff
+}
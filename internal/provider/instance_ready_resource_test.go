@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInstanceReadyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceReadyResourceConfig("one", "5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_instance_ready.test", "timeout", "5m"),
+					resource.TestCheckResourceAttrSet("harperdb_instance_ready.test", "id"),
+					resource.TestMatchResourceAttr("harperdb_instance_ready.test", "version", regexp.MustCompile(`^\d+\.\d+`)),
+				),
+			},
+			{
+				Config: testAccInstanceReadyResourceConfig("two", "5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_instance_ready.test", "triggers.instance", "two"),
+				),
+			},
+			{
+				Config:      testAccInstanceReadyResourceConfig("two", "-1m"),
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+		},
+	})
+}
+
+func testAccInstanceReadyResourceConfig(trigger, timeout string) string {
+	return fmt.Sprintf(`
+	%s
+
+resource "harperdb_instance_ready" "test" {
+  triggers = {
+    instance = %q
+  }
+  timeout = %q
+}
+`, testAccProviderTF(), trigger, timeout)
+}
+
+func TestWaitForReady(t *testing.T) {
+	client, operations := testInstanceServer(t, 3)
+
+	version, err := waitForReady(context.Background(), client, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if version != "4.1.0" {
+		t.Errorf("expected version 4.1.0, got %q", version)
+	}
+
+	if got := operations(); len(got) != 4 {
+		t.Errorf("expected 4 attempts, got %v", got)
+	}
+}
+
+func TestWaitForReady_timeout(t *testing.T) {
+	client, _ := testInstanceServer(t, 1<<30)
+
+	if _, err := waitForReady(context.Background(), client, 50*time.Millisecond); err == nil {
+		t.Fatal("expected an error for an instance which never becomes ready")
+	}
+}
+
+func TestReadyBackoff(t *testing.T) {
+	minBackoff, maxBackoff := readyMinBackoff, readyMaxBackoff
+	readyMinBackoff, readyMaxBackoff = time.Second, 30*time.Second
+	t.Cleanup(func() {
+		readyMinBackoff, readyMaxBackoff = minBackoff, maxBackoff
+	})
+
+	want := []time.Duration{0, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second}
+	for attempt, expected := range want {
+		if got := readyBackoff(attempt); got != expected {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, expected, got)
+		}
+	}
+
+	if got := readyBackoff(1000); got != readyMaxBackoff {
+		t.Errorf("expected the backoff to be capped at %s, got %s", readyMaxBackoff, got)
+	}
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"sync"
+)
+
+// roleLocks serializes read-modify-write cycles on roles. HarperDB replaces
+// the whole permission document of a role on alter_role, concurrent updates
+// would otherwise overwrite each other. The locks only cover this provider
+// process: two Terraform runs, or edits made in Studio, can still race.
+var roleLocks = newMutexKV()
+
+// mutexKV is a set of mutexes keyed by string, created on first use.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock locks the mutex of key.
+func (m *mutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock unlocks the mutex of key.
+func (m *mutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+
+	return mutex
+}
@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"encoding/json"
+	"time"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+)
+
+// Operations which the SDK does not cover, or whose responses it only decodes
+// partially. They are sent with harperdb.Client.RawRequest and follow the
+// structure of the SDK operations.
+
+// opDescribeTable is describe_table, decoded into describeTableResponse.
+type opDescribeTable struct {
+	Schema string `json:"schema"`
+	Table  string `json:"table"`
+}
+
+func (o opDescribeTable) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+		opDescribeTable
+	}
+	return Return{
+		Operation:       harperdb.OP_DESCRIBE_TABLE,
+		opDescribeTable: o,
+	}
+}
+
+// describeTableResponse extends the SDK response with the fields it omits.
+type describeTableResponse struct {
+	harperdb.DescribeTableResponse
+	ClusteringStreamName string  `json:"clustering_stream_name"`
+	LastUpdatedRecord    float64 `json:"last_updated_record"`
+}
+
+// opSystemInformation is system_information restricted to the given
+// sections, decoded into systemInformationResponse. All sections are returned
+// when Attributes is empty.
+type opSystemInformation struct {
+	Attributes []string `json:"attributes,omitempty"`
+}
+
+func (o opSystemInformation) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+		opSystemInformation
+	}
+	return Return{
+		Operation:           harperdb.OP_SYSTEM_INFORMATION,
+		opSystemInformation: o,
+	}
+}
+
+// systemInformationResponse is the subset of the system_information response
+// exposed by the provider. Sections which were not requested, or which the
+// server does not report, are nil. Numbers are decoded as float64 since
+// HarperDB passes through whatever the underlying system library reports.
+type systemInformationResponse struct {
+	System *struct {
+		Platform    string `json:"platform"`
+		Distro      string `json:"distro"`
+		Release     string `json:"release"`
+		Kernel      string `json:"kernel"`
+		Arch        string `json:"arch"`
+		Hostname    string `json:"hostname"`
+		NodeVersion string `json:"node_version"`
+		NPMVersion  string `json:"npm_version"`
+		HDBVersion  string `json:"hdb_version"`
+	} `json:"system"`
+	Time *struct {
+		Current      float64 `json:"current"`
+		Uptime       float64 `json:"uptime"`
+		Timezone     string  `json:"timezone"`
+		TimezoneName string  `json:"timezoneName"`
+	} `json:"time"`
+	CPU *struct {
+		Manufacturer  string                 `json:"manufacturer"`
+		Brand         string                 `json:"brand"`
+		Speed         float64                `json:"speed"`
+		Cores         float64                `json:"cores"`
+		PhysicalCores float64                `json:"physicalCores"`
+		CurrentLoad   map[string]interface{} `json:"current_load"`
+	} `json:"cpu"`
+	Memory *struct {
+		Total     float64 `json:"total"`
+		Free      float64 `json:"free"`
+		Used      float64 `json:"used"`
+		Active    float64 `json:"active"`
+		Available float64 `json:"available"`
+		SwapTotal float64 `json:"swaptotal"`
+		SwapUsed  float64 `json:"swapused"`
+		SwapFree  float64 `json:"swapfree"`
+	} `json:"memory"`
+	Disk *struct {
+		Size []struct {
+			FS        string  `json:"fs"`
+			Type      string  `json:"type"`
+			Mount     string  `json:"mount"`
+			Size      float64 `json:"size"`
+			Used      float64 `json:"used"`
+			Available float64 `json:"available"`
+			Use       float64 `json:"use"`
+		} `json:"size"`
+	} `json:"disk"`
+	Network *struct {
+		DefaultInterface string `json:"default_interface"`
+		Interfaces       []struct {
+			Iface     string `json:"iface"`
+			IP4       string `json:"ip4"`
+			IP6       string `json:"ip6"`
+			Mac       string `json:"mac"`
+			OperState string `json:"operstate"`
+		} `json:"interfaces"`
+	} `json:"network"`
+	HarperDBProcesses json.RawMessage `json:"harperdb_processes"`
+	TableSize         *[]struct {
+		Schema                    string  `json:"schema"`
+		Table                     string  `json:"table"`
+		TableSize                 float64 `json:"table_size"`
+		RecordCount               float64 `json:"record_count"`
+		TransactionLogSize        float64 `json:"transaction_log_size"`
+		TransactionLogRecordCount float64 `json:"transaction_log_record_count"`
+	} `json:"table_size"`
+	Replication json.RawMessage `json:"replication"`
+}
+
+// opNameSearchByConditions is the name of search_by_conditions, which the SDK
+// lacks.
+const opNameSearchByConditions = "search_by_conditions"
+
+// opSearchByHash is search_by_hash with values of any JSON type.
+type opSearchByHash struct {
+	Schema        string            `json:"schema"`
+	Table         string            `json:"table"`
+	HashValues    []json.RawMessage `json:"hash_values"`
+	GetAttributes []string          `json:"get_attributes"`
+}
+
+func (o opSearchByHash) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+		opSearchByHash
+	}
+	return Return{
+		Operation:      harperdb.OP_SEARCH_BY_HASH,
+		opSearchByHash: o,
+	}
+}
+
+// opSearchByValue is search_by_value with a value of any JSON type.
+type opSearchByValue struct {
+	Schema          string          `json:"schema"`
+	Table           string          `json:"table"`
+	SearchAttribute string          `json:"search_attribute"`
+	SearchValue     json.RawMessage `json:"search_value"`
+	GetAttributes   []string        `json:"get_attributes"`
+}
+
+func (o opSearchByValue) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+		opSearchByValue
+	}
+	return Return{
+		Operation:       harperdb.OP_SEARCH_BY_VALUE,
+		opSearchByValue: o,
+	}
+}
+
+// searchCondition is a single condition of search_by_conditions.
+type searchCondition struct {
+	SearchAttribute string          `json:"search_attribute"`
+	SearchType      string          `json:"search_type"`
+	SearchValue     json.RawMessage `json:"search_value"`
+}
+
+// searchSort is the sort order of search_by_conditions.
+type searchSort struct {
+	Attribute  string `json:"attribute"`
+	Descending bool   `json:"descending"`
+}
+
+// opSearchByConditions is search_by_conditions.
+type opSearchByConditions struct {
+	Schema        string            `json:"schema"`
+	Table         string            `json:"table"`
+	Operator      string            `json:"operator,omitempty"`
+	Limit         int64             `json:"limit,omitempty"`
+	Sort          *searchSort       `json:"sort,omitempty"`
+	GetAttributes []string          `json:"get_attributes"`
+	Conditions    []searchCondition `json:"conditions"`
+}
+
+func (o opSearchByConditions) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+		opSearchByConditions
+	}
+	return Return{
+		Operation:            opNameSearchByConditions,
+		opSearchByConditions: o,
+	}
+}
+
+// Names of the operations managing the instance, which the SDK lacks.
+const (
+	opNameGetConfiguration = "get_configuration"
+	opNameSetConfiguration = "set_configuration"
+	opNameRestart          = "restart"
+	opNameRestartService   = "restart_service"
+)
+
+// opGetConfiguration is get_configuration.
+type opGetConfiguration struct{}
+
+func (o opGetConfiguration) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+	}
+	return Return{
+		Operation: opNameGetConfiguration,
+	}
+}
+
+// opSetConfiguration is set_configuration. The keys are the configuration
+// paths with "_" as separator, for example logging_level.
+type opSetConfiguration map[string]interface{}
+
+func (o opSetConfiguration) Prepare() interface{} {
+	prepared := make(map[string]interface{}, len(o)+1)
+	for key, value := range o {
+		prepared[key] = value
+	}
+	prepared["operation"] = opNameSetConfiguration
+
+	return prepared
+}
+
+// opRestart is restart.
+type opRestart struct{}
+
+func (o opRestart) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+	}
+	return Return{
+		Operation: opNameRestart,
+	}
+}
+
+// opRestartService is restart_service.
+type opRestartService struct {
+	Service string `json:"service"`
+}
+
+func (o opRestartService) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+		opRestartService
+	}
+	return Return{
+		Operation:        opNameRestartService,
+		opRestartService: o,
+	}
+}
+
+// clusterSubscription is the replication of a table with a cluster node as
+// of HarperDB 4. The SDK models the channels of HarperDB 3 instead.
+type clusterSubscription struct {
+	Schema    string `json:"schema"`
+	Table     string `json:"table"`
+	Publish   bool   `json:"publish"`
+	Subscribe bool   `json:"subscribe"`
+}
+
+// opAddNode is add_node as of HarperDB 4, which identifies nodes by name
+// only. The SDK still sends the host and port of HarperDB 3.
+type opAddNode struct {
+	NodeName      string                `json:"node_name"`
+	Subscriptions []clusterSubscription `json:"subscriptions"`
+}
+
+func (o opAddNode) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+		opAddNode
+	}
+	return Return{
+		Operation: harperdb.OP_ADD_NODE,
+		opAddNode: o,
+	}
+}
+
+// opUpdateNode is update_node. HarperDB upserts the given subscriptions and
+// leaves the others untouched. The SDK sends the node name as name rather
+// than node_name.
+type opUpdateNode struct {
+	NodeName      string                `json:"node_name"`
+	Subscriptions []clusterSubscription `json:"subscriptions"`
+}
+
+func (o opUpdateNode) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+		opUpdateNode
+	}
+	return Return{
+		Operation:    harperdb.OP_UPDATE_NODE,
+		opUpdateNode: o,
+	}
+}
+
+// opClusterStatus is cluster_status, decoded into clusterStatusResponse.
+type opClusterStatus struct{}
+
+func (o opClusterStatus) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+	}
+	return Return{
+		Operation: harperdb.OP_CLUSTER_STATUS,
+	}
+}
+
+// clusterStatusResponse is the cluster_status response of HarperDB 4.
+type clusterStatusResponse struct {
+	NodeName    interface{}         `json:"node_name"` // a number when clustering is disabled
+	IsEnabled   bool                `json:"is_enabled"`
+	Connections []clusterConnection `json:"connections"`
+}
+
+// clusterConnection is a node the instance replicates with. Latency and
+// ports are missing while the connection is not open.
+type clusterConnection struct {
+	NodeName  string   `json:"node_name"`
+	Status    string   `json:"status"`
+	LatencyMS *float64 `json:"latency_ms"`
+	Uptime    string   `json:"uptime"`
+	Ports     struct {
+		Clustering    *int64 `json:"clustering"`
+		OperationsAPI *int64 `json:"operations_api"`
+	} `json:"ports"`
+	Subscriptions []clusterSubscription `json:"subscriptions"`
+	SystemInfo    struct {
+		HDBVersion string `json:"hdb_version"`
+	} `json:"system_info"`
+}
+
+// opNameClusterNetwork is the name of cluster_network, added in HarperDB 4,
+// which the SDK lacks.
+const opNameClusterNetwork = "cluster_network"
+
+// opClusterNetwork is cluster_network, decoded into clusterNetworkResponse.
+// Timeout is how long to wait for the nodes to answer, in milliseconds.
+type opClusterNetwork struct {
+	Timeout        int64 `json:"timeout,omitempty"`
+	ConnectedNodes bool  `json:"connected_nodes"`
+	Routes         bool  `json:"routes"`
+}
+
+func (o opClusterNetwork) Prepare() interface{} {
+	type Return struct {
+		Operation string `json:"operation"`
+		opClusterNetwork
+	}
+	return Return{
+		Operation:        opNameClusterNetwork,
+		opClusterNetwork: o,
+	}
+}
+
+// clusterNetworkResponse lists the nodes of the cluster which answered.
+type clusterNetworkResponse struct {
+	Nodes []struct {
+		Name           string   `json:"name"`
+		ResponseTime   float64  `json:"response_time"`
+		ConnectedNodes []string `json:"connected_nodes"`
+	} `json:"nodes"`
+}
+
+// clusterRoutesResponse is the cluster_get_routes response of HarperDB 4.
+// The SDK decodes the leaf routes from the wrong key.
+type clusterRoutesResponse struct {
+	Hub  []harperdb.Route `json:"hub"`
+	Leaf []harperdb.Route `json:"leaf"`
+}
+
+// formatTimestamp renders a HarperDB timestamp, milliseconds since the epoch,
+// as RFC 3339. Zero timestamps are not reported by HarperDB and yield an
+// empty string.
+func formatTimestamp(ms float64) string {
+	if ms == 0 {
+		return ""
+	}
+
+	return time.UnixMilli(int64(ms)).UTC().Format(time.RFC3339)
+}
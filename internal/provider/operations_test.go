@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDescribeTableResponse(t *testing.T) {
+	var table describeTableResponse
+	err := json.Unmarshal([]byte(`{
+		"__createdtime__": 1682000000000,
+		"__updatedtime__": 1682000000000,
+		"hash_attribute": "dog_id",
+		"name": "dogs",
+		"schema": "dev",
+		"clustering_stream_name": "3f7c4b3d3a0d1a0f",
+		"record_count": 2,
+		"last_updated_record": 1682000001234.567,
+		"attributes": [{"attribute": "dog_id"}, {"attribute": "name"}]
+	}`), &table)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if table.HashAttribute != "dog_id" || table.ClusteringStreamName != "3f7c4b3d3a0d1a0f" || len(table.Attributes) != 2 {
+		t.Errorf("unexpected table description: %+v", table)
+	}
+
+	if got := formatTimestamp(float64(table.CreatedTime)); got != "2023-04-20T14:13:20Z" {
+		t.Errorf("expected created time 2023-04-20T14:13:20Z, got %s", got)
+	}
+
+	if got := formatTimestamp(table.LastUpdatedRecord); got != "2023-04-20T14:13:21Z" {
+		t.Errorf("expected last updated 2023-04-20T14:13:21Z, got %s", got)
+	}
+
+	if got := formatTimestamp(0); got != "" {
+		t.Errorf("expected an empty string for zero timestamps, got %s", got)
+	}
+}
+
+func TestClusterStatusResponse(t *testing.T) {
+	var status clusterStatusResponse
+	err := json.Unmarshal([]byte(`{
+		"node_name": "node-1",
+		"is_enabled": true,
+		"connections": [{
+			"node_name": "node-2",
+			"status": "open",
+			"ports": {"clustering": 12345, "operations_api": 9925},
+			"latency_ms": 13,
+			"uptime": "30d 1h 18m 8s",
+			"subscriptions": [{"schema": "dev", "table": "dog", "publish": true, "subscribe": false}]
+		}]
+	}`), &status)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !status.IsEnabled || len(status.Connections) != 1 {
+		t.Fatalf("unexpected cluster status: %+v", status)
+	}
+
+	want := clusterSubscription{Schema: "dev", Table: "dog", Publish: true}
+	if connection := status.Connections[0]; connection.NodeName != "node-2" || len(connection.Subscriptions) != 1 || connection.Subscriptions[0] != want {
+		t.Errorf("unexpected connection: %+v", connection)
+	}
+
+	// Clustering disabled reports a number as the node name.
+	if err := json.Unmarshal([]byte(`{"node_name": 1, "is_enabled": false}`), &status); err != nil {
+		t.Errorf("unexpected error for disabled clustering: %s", err)
+	}
+}
+
+func TestClusterRoutesResponse(t *testing.T) {
+	var routes clusterRoutesResponse
+	err := json.Unmarshal([]byte(`{
+		"hub": [{"host": "10.0.0.1", "port": 12345}],
+		"leaf": [{"host": "10.0.0.2", "port": 12346}]
+	}`), &routes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(routes.Hub) != 1 || len(routes.Leaf) != 1 || routes.Leaf[0].Host != "10.0.0.2" || routes.Leaf[0].Port != 12346 {
+		t.Errorf("unexpected routes: %+v", routes)
+	}
+}
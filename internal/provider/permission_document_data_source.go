@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Operations which can be granted by a permission document statement.
+const (
+	operationRead   = "read"
+	operationInsert = "insert"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionDocumentDataSource{}
+
+func NewPermissionDocumentDataSource() datasource.DataSource {
+	return &PermissionDocumentDataSource{}
+}
+
+// PermissionDocumentDataSource defines the data source implementation.
+// It does not talk to HarperDB, the document is computed locally.
+type PermissionDocumentDataSource struct{}
+
+// PermissionDocumentDataSourceModel describes the data source data model.
+type PermissionDocumentDataSourceModel struct {
+	ID              types.String                       `tfsdk:"id"`
+	SourceDocuments []PermissionJSONValue              `tfsdk:"source_documents"`
+	Statements      []PermissionDocumentStatementModel `tfsdk:"statement"`
+	JSON            types.String                       `tfsdk:"json"`
+}
+
+// PermissionDocumentStatementModel describes a single statement block.
+type PermissionDocumentStatementModel struct {
+	Schema     types.String `tfsdk:"schema"`
+	Tables     types.Set    `tfsdk:"tables"`
+	Operations types.Set    `tfsdk:"operations"`
+	Attributes types.Set    `tfsdk:"attributes"`
+}
+
+func (d *PermissionDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_document"
+}
+
+func (d *PermissionDocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Composes a HarperDB permission document usable as `permission_json` of a `harperdb_role`. " +
+			"Grants of all source documents and statements are merged, a permission is granted when any of them grants it. " +
+			"A grant on a whole table absorbs grants on attributes of the same table for the operations it includes, " +
+			"other combinations of whole table and attribute grants on the same table are rejected.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Hash of the generated document",
+				Computed:            true,
+			},
+			"source_documents": schema.ListAttribute{
+				MarkdownDescription: "Permission documents to merge into the result, for example the `json` of other `harperdb_permission_document` data sources",
+				Optional:            true,
+				ElementType:         PermissionJSONType{},
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(permissionJSONValidator{}),
+				},
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Normalized permission document",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				MarkdownDescription: "Grant of operations on tables of a schema",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"schema": schema.StringAttribute{
+							MarkdownDescription: "Schema name",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"tables": schema.SetAttribute{
+							MarkdownDescription: "Table names",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"operations": schema.SetAttribute{
+							MarkdownDescription: "Granted operations, any of `read`, `insert`, `update` and `delete`",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(operationRead, operationInsert, operationUpdate, operationDelete)),
+							},
+						},
+						"attributes": schema.SetAttribute{
+							MarkdownDescription: "Restricts the grant to these attributes. The table level grant is raised as well, " +
+								"as HarperDB only honours attribute permissions granted by the table. `delete` cannot be granted on attributes.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionDocumentDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	perm := harperdb.Permission{}
+
+	for i, document := range data.SourceDocuments {
+		source, err := parsePermissionJSON(document.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_documents").AtListIndex(i),
+				"Invalid Permission Document",
+				fmt.Sprintf("The value is not a valid HarperDB permission document: %s", err),
+			)
+			continue
+		}
+
+		if err := mergePermission(perm, source); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("source_documents").AtListIndex(i),
+				"Conflicting Grants",
+				fmt.Sprintf("Unable to merge the document: %s.", err),
+			)
+		}
+	}
+
+	for i, statement := range data.Statements {
+		statementPath := path.Root("statement").AtListIndex(i)
+
+		var tables, operations, attributes []string
+		resp.Diagnostics.Append(statement.Tables.ElementsAs(ctx, &tables, false)...)
+		resp.Diagnostics.Append(statement.Operations.ElementsAs(ctx, &operations, false)...)
+		resp.Diagnostics.Append(statement.Attributes.ElementsAs(ctx, &attributes, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if len(attributes) > 0 && contains(operations, operationDelete) {
+			resp.Diagnostics.AddAttributeError(
+				statementPath.AtName("operations"),
+				"Invalid Attribute Operation",
+				"The delete operation can only be granted on whole tables, remove it or remove the attributes of this statement.",
+			)
+			continue
+		}
+
+		if err := mergePermission(perm, statementPermission(statement.Schema.ValueString(), tables, operations, attributes)); err != nil {
+			resp.Diagnostics.AddAttributeError(statementPath, "Conflicting Grants", fmt.Sprintf("Unable to merge the statement: %s.", err))
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, err := normalizePermission(perm)
+	if err != nil {
+		resp.Diagnostics.AddError("Permission Document Error", fmt.Sprintf("Unable to render the permission document, got error: %s", err))
+		return
+	}
+
+	data.JSON = types.StringValue(document)
+	data.ID = types.StringValue(fmt.Sprintf("%x", sha256.Sum256([]byte(document))))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// statementPermission builds the permission document granted by a single
+// statement.
+func statementPermission(schemaName string, tables, operations, attributes []string) harperdb.Permission {
+	grant := harperdb.TablePermission{
+		Read:   contains(operations, operationRead),
+		Insert: contains(operations, operationInsert),
+		Update: contains(operations, operationUpdate),
+		Delete: contains(operations, operationDelete),
+	}
+
+	for _, attribute := range attributes {
+		grant.AttributePermissions = append(grant.AttributePermissions, harperdb.AttributePermissions{
+			AttributeName: attribute,
+			Read:          grant.Read,
+			Insert:        grant.Insert,
+			Update:        grant.Update,
+		})
+	}
+
+	sp := harperdb.SchemaPermission{Tables: map[string]harperdb.TablePermission{}}
+	for _, table := range tables {
+		sp.AddTablePermission(table, grant)
+	}
+
+	perm := harperdb.Permission{}
+	perm.AddSchemaPermission(schemaName, sp)
+
+	return perm
+}
+
+// mergePermission merges the grants of src into dst. Flags and operations are
+// combined with a logical or, attribute permissions are merged by name, see
+// mergeTablePermission, and structure_user schema lists are joined. Both
+// documents must hold harperdb.SchemaPermission values for schemas, as
+// returned by parsePermissionJSON.
+func mergePermission(dst, src harperdb.Permission) error {
+	for key, value := range src {
+		if key == permissionKeyStructureUser {
+			dstAll, dstSchemas, _ := structureUser(dst[key])
+			srcAll, srcSchemas, _ := structureUser(value)
+
+			schemas := append([]string(nil), dstSchemas...)
+			for _, name := range srcSchemas {
+				if !contains(schemas, name) {
+					schemas = append(schemas, name)
+				}
+			}
+			setStructureUser(dst, dstAll || srcAll, schemas)
+			continue
+		}
+
+		if isPermissionFlag(key) {
+			flag, _ := value.(bool)
+			dst[key] = permissionFlag(dst, key) || flag
+			continue
+		}
+
+		srcSchema, ok := value.(harperdb.SchemaPermission)
+		if !ok {
+			continue
+		}
+
+		dstSchema, ok := dst[key].(harperdb.SchemaPermission)
+		if !ok {
+			dstSchema = harperdb.SchemaPermission{Tables: map[string]harperdb.TablePermission{}}
+		}
+
+		for table, srcTable := range srcSchema.Tables {
+			merged, err := mergeTablePermission(dstSchema.Tables[table], srcTable)
+			if err != nil {
+				return fmt.Errorf("table %s.%s: %w", key, table, err)
+			}
+			dstSchema.AddTablePermission(table, merged)
+		}
+
+		dst.AddSchemaPermission(key, dstSchema)
+	}
+
+	return nil
+}
+
+// mergeTablePermission merges two grants on the same table. Attribute
+// permissions restrict every operation of the table. A grant on the whole
+// table absorbs a grant on attributes whose operations it includes; otherwise
+// the union cannot be expressed without knowing all attributes of the table,
+// and an error is returned rather than widening or narrowing either grant.
+func mergeTablePermission(dst, src harperdb.TablePermission) (harperdb.TablePermission, error) {
+	merged := harperdb.TablePermission{
+		Read:   dst.Read || src.Read,
+		Insert: dst.Insert || src.Insert,
+		Update: dst.Update || src.Update,
+		Delete: dst.Delete || src.Delete,
+	}
+
+	dstWhole, srcWhole := grantsWholeTable(dst), grantsWholeTable(src)
+	switch {
+	case dstWhole && srcWhole:
+		merged.AttributePermissions = []harperdb.AttributePermissions{}
+		return merged, nil
+	case dstWhole || srcWhole:
+		whole, attributes := dst, src
+		if srcWhole {
+			whole, attributes = src, dst
+		}
+
+		if !includesOperations(whole, attributes) {
+			return harperdb.TablePermission{}, errors.New("a grant on the whole table cannot be merged with a grant on attributes " +
+				"for operations the whole table is not granted, grant these operations on the whole table or on attributes only")
+		}
+
+		whole.AttributePermissions = []harperdb.AttributePermissions{}
+		return whole, nil
+	}
+
+	index := map[string]int{}
+	for _, attributes := range [][]harperdb.AttributePermissions{dst.AttributePermissions, src.AttributePermissions} {
+		for _, ap := range attributes {
+			i, ok := index[ap.AttributeName]
+			if !ok {
+				index[ap.AttributeName] = len(merged.AttributePermissions)
+				merged.AttributePermissions = append(merged.AttributePermissions, ap)
+				continue
+			}
+
+			existing := &merged.AttributePermissions[i]
+			existing.Read = existing.Read || ap.Read
+			existing.Insert = existing.Insert || ap.Insert
+			existing.Update = existing.Update || ap.Update
+		}
+	}
+
+	return merged, nil
+}
+
+// grantsWholeTable reports whether tp grants an operation without restricting
+// it to attributes.
+func grantsWholeTable(tp harperdb.TablePermission) bool {
+	return len(tp.AttributePermissions) == 0 && (tp.Read || tp.Insert || tp.Update || tp.Delete)
+}
+
+// includesOperations reports whether whole grants every operation granted by
+// tp, on the table or on any of its attributes.
+func includesOperations(whole, tp harperdb.TablePermission) bool {
+	read, insert, update := tp.Read, tp.Insert, tp.Update
+	for _, ap := range tp.AttributePermissions {
+		read, insert, update = read || ap.Read, insert || ap.Insert, update || ap.Update
+	}
+
+	return (whole.Read || !read) && (whole.Insert || !insert) && (whole.Update || !update) && (whole.Delete || !tp.Delete)
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPermissionDocumentDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionDocumentDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.harperdb_permission_document.merged",
+						"json",
+						`{"dogs":{"tables":{"breeds":{"read":true,"insert":true,"update":false,"delete":false,"attribute_permissions":[]},`+
+							`"owners":{"read":true,"insert":false,"update":false,"delete":false,"attribute_permissions":[]}}}}`,
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionDocumentDataSourceConfig() string {
+	return fmt.Sprintf(`
+	%s
+
+data "harperdb_permission_document" "team_a" {
+  statement {
+    schema     = "dogs"
+    tables     = ["breeds", "owners"]
+    operations = ["read"]
+  }
+}
+
+data "harperdb_permission_document" "merged" {
+  source_documents = [data.harperdb_permission_document.team_a.json]
+
+  statement {
+    schema     = "dogs"
+    tables     = ["breeds"]
+    operations = ["read", "insert"]
+  }
+
+  statement {
+    schema     = "dogs"
+    tables     = ["owners"]
+    operations = ["read"]
+    attributes = ["name"]
+  }
+}
+`, testAccProviderTF())
+}
+
+func TestMergePermission(t *testing.T) {
+	perm := harperdb.Permission{}
+
+	for _, src := range []harperdb.Permission{
+		statementPermission("dogs", []string{"breeds"}, []string{operationRead}, []string{"name"}),
+		statementPermission("dogs", []string{"breeds"}, []string{operationInsert}, []string{"name", "owner"}),
+		{permissionKeyClusterUser: true},
+		{permissionKeyClusterUser: false},
+		{permissionKeyStructureUser: []string{"dogs"}},
+		{permissionKeyStructureUser: []string{"cats", "dogs"}},
+	} {
+		if err := mergePermission(perm, src); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	got, err := normalizePermission(perm)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"cluster_user":true,"dogs":{"tables":{"breeds":{"read":true,"insert":true,"update":false,"delete":false,"attribute_permissions":[` +
+		`{"attribute_name":"name","read":true,"insert":true,"update":false},` +
+		`{"attribute_name":"owner","read":false,"insert":true,"update":false}]}}},"structure_user":["cats","dogs"]}`
+	if got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestMergePermission_wholeTable(t *testing.T) {
+	wholeRead := statementPermission("dev", []string{"dogs"}, []string{operationRead, operationInsert}, nil)
+	nameInsert := statementPermission("dev", []string{"dogs"}, []string{operationInsert}, []string{"name"})
+	nameUpdate := statementPermission("dev", []string{"dogs"}, []string{operationUpdate}, []string{"name"})
+
+	testCases := map[string]struct {
+		statements []harperdb.Permission
+		want       string
+	}{
+		"table first": {
+			statements: []harperdb.Permission{wholeRead, nameInsert},
+			want:       `{"dev":{"tables":{"dogs":{"read":true,"insert":true,"update":false,"delete":false,"attribute_permissions":[]}}}}`,
+		},
+		"attributes first": {
+			statements: []harperdb.Permission{nameInsert, wholeRead},
+			want:       `{"dev":{"tables":{"dogs":{"read":true,"insert":true,"update":false,"delete":false,"attribute_permissions":[]}}}}`,
+		},
+		// Merging would grant update on every attribute.
+		"widening table first": {
+			statements: []harperdb.Permission{wholeRead, nameUpdate},
+		},
+		"widening attributes first": {
+			statements: []harperdb.Permission{nameUpdate, wholeRead},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			perm := harperdb.Permission{}
+
+			var err error
+			for _, statement := range tc.statements {
+				if err = mergePermission(perm, statement); err != nil {
+					break
+				}
+			}
+
+			if tc.want == "" {
+				if err == nil {
+					t.Fatal("expected an error for conflicting grants")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := normalizePermission(perm)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the custom types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = PermissionJSONType{}
+var _ basetypes.StringValuableWithSemanticEquals = PermissionJSONValue{}
+var _ validator.String = permissionJSONValidator{}
+
+// PermissionJSONType is a string holding a native HarperDB permission
+// document, as accepted by add_role and returned by list_roles.
+type PermissionJSONType struct {
+	basetypes.StringType
+}
+
+func (t PermissionJSONType) Equal(o attr.Type) bool {
+	_, ok := o.(PermissionJSONType)
+	return ok
+}
+
+func (t PermissionJSONType) String() string {
+	return "PermissionJSONType"
+}
+
+func (t PermissionJSONType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return PermissionJSONValue{StringValue: in}, nil
+}
+
+func (t PermissionJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t PermissionJSONType) ValueType(ctx context.Context) attr.Value {
+	return PermissionJSONValue{}
+}
+
+// PermissionJSONValue is the value of a PermissionJSONType.
+type PermissionJSONValue struct {
+	basetypes.StringValue
+}
+
+// NewPermissionJSONNull returns a null permission document.
+func NewPermissionJSONNull() PermissionJSONValue {
+	return PermissionJSONValue{StringValue: types.StringNull()}
+}
+
+// NewPermissionJSONValue returns a known permission document.
+func NewPermissionJSONValue(value string) PermissionJSONValue {
+	return PermissionJSONValue{StringValue: types.StringValue(value)}
+}
+
+func (v PermissionJSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(PermissionJSONValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v PermissionJSONValue) Type(ctx context.Context) attr.Type {
+	return PermissionJSONType{}
+}
+
+// StringSemanticEquals reports whether both documents grant the same
+// permissions once normalized, ignoring whitespace, key ordering, the order
+// of attribute permissions and flags which are explicitly false.
+func (v PermissionJSONValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(PermissionJSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	prior, err := normalizePermissionJSON(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	proposed, err := normalizePermissionJSON(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return prior == proposed, diags
+}
+
+// parsePermissionJSON decodes a permission document, rejecting anything that
+// does not fit the harperdb.Permission structure.
+func parsePermissionJSON(document string) (harperdb.Permission, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return nil, fmt.Errorf("permission document must be a JSON object: %w", err)
+	}
+
+	perm := harperdb.Permission{}
+	for key, value := range raw {
+		if key == permissionKeyStructureUser {
+			var flag interface{}
+			if err := json.Unmarshal(value, &flag); err != nil {
+				return nil, err
+			}
+
+			all, schemas, err := structureUser(flag)
+			if err != nil {
+				return nil, err
+			}
+			setStructureUser(perm, all, schemas)
+			continue
+		}
+
+		if isPermissionFlag(key) {
+			var flag bool
+			if err := json.Unmarshal(value, &flag); err != nil {
+				return nil, fmt.Errorf("%q must be a boolean", key)
+			}
+			perm[key] = flag
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(value))
+		dec.DisallowUnknownFields()
+
+		var sp harperdb.SchemaPermission
+		if err := dec.Decode(&sp); err != nil {
+			return nil, fmt.Errorf("schema %q: %w", key, err)
+		}
+		perm.AddSchemaPermission(key, sp)
+	}
+
+	return perm, nil
+}
+
+// normalizePermission renders a permission document in its canonical form:
+// sorted keys, sorted attribute permissions and structure_user schemas, no
+// whitespace and no false flags.
+func normalizePermission(perm harperdb.Permission) (string, error) {
+	normalized := harperdb.Permission{}
+	for key, value := range perm {
+		if key == permissionKeyStructureUser {
+			all, schemas, err := structureUser(value)
+			if err != nil {
+				return "", err
+			}
+
+			sorted := append([]string(nil), schemas...)
+			sort.Strings(sorted)
+			setStructureUser(normalized, all, sorted)
+			continue
+		}
+
+		if isPermissionFlag(key) {
+			if flag, ok := value.(bool); ok && flag {
+				normalized[key] = true
+			}
+			continue
+		}
+
+		sp, ok := value.(harperdb.SchemaPermission)
+		if !ok {
+			return "", fmt.Errorf("schema %q: unexpected value of type %T", key, value)
+		}
+
+		tables := make(map[string]harperdb.TablePermission, len(sp.Tables))
+		for name, tp := range sp.Tables {
+			attributes := make([]harperdb.AttributePermissions, len(tp.AttributePermissions))
+			copy(attributes, tp.AttributePermissions)
+			sort.SliceStable(attributes, func(i, j int) bool {
+				return attributes[i].AttributeName < attributes[j].AttributeName
+			})
+
+			tp.AttributePermissions = attributes
+			tables[name] = tp
+		}
+
+		normalized.AddSchemaPermission(key, harperdb.SchemaPermission{Tables: tables})
+	}
+
+	b, err := json.Marshal(normalized)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// normalizePermissionJSON parses and normalizes a permission document.
+func normalizePermissionJSON(document string) (string, error) {
+	perm, err := parsePermissionJSON(document)
+	if err != nil {
+		return "", err
+	}
+
+	return normalizePermission(perm)
+}
+
+// permissionJSONValidator ensures a string is a valid permission document.
+type permissionJSONValidator struct{}
+
+func (v permissionJSONValidator) Description(ctx context.Context) string {
+	return "value must be a HarperDB permission document"
+}
+
+func (v permissionJSONValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v permissionJSONValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parsePermissionJSON(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Permission Document",
+			fmt.Sprintf("The value is not a valid HarperDB permission document: %s", err),
+		)
+	}
+}
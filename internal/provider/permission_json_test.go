@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNormalizePermissionJSON(t *testing.T) {
+	testCases := map[string]struct {
+		document  string
+		want      string
+		wantError bool
+	}{
+		"empty": {
+			document: `{}`,
+			want:     `{}`,
+		},
+		"false-flags": {
+			document: `{"super_user": false, "cluster_user": false}`,
+			want:     `{}`,
+		},
+		"super-user": {
+			document: `{"super_user": true}`,
+			want:     `{"super_user":true}`,
+		},
+		"schema": {
+			document: `{
+				"dogs": {
+					"tables": {
+						"breeds": {
+							"read": true,
+							"attribute_permissions": [
+								{"attribute_name": "owner", "read": false},
+								{"attribute_name": "name", "read": true}
+							]
+						}
+					}
+				}
+			}`,
+			want: `{"dogs":{"tables":{"breeds":{"read":true,"insert":false,"update":false,"delete":false,` +
+				`"attribute_permissions":[{"attribute_name":"name","read":true,"insert":false,"update":false},` +
+				`{"attribute_name":"owner","read":false,"insert":false,"update":false}]}}}}`,
+		},
+		"structure-user": {
+			document: `{"structure_user": true}`,
+			want:     `{"structure_user":true}`,
+		},
+		"structure-user-schemas": {
+			document: `{"structure_user": ["dogs", "cats"]}`,
+			want:     `{"structure_user":["cats","dogs"]}`,
+		},
+		"structure-user-none": {
+			document: `{"structure_user": []}`,
+			want:     `{}`,
+		},
+		"structure-user-invalid": {
+			document:  `{"structure_user": [1]}`,
+			wantError: true,
+		},
+		"not-an-object": {
+			document:  `[]`,
+			wantError: true,
+		},
+		"flag-not-boolean": {
+			document:  `{"super_user": "yes"}`,
+			wantError: true,
+		},
+		"unknown-table-field": {
+			document:  `{"dogs": {"tables": {"breeds": {"reed": true}}}}`,
+			wantError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got, err := normalizePermissionJSON(tc.document)
+			if (err != nil) != tc.wantError {
+				t.Fatalf("expected error %t, got %v", tc.wantError, err)
+			}
+
+			if got != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPermissionJSONSemanticEquals(t *testing.T) {
+	prior := NewPermissionJSONValue(`{"dogs":{"tables":{"breeds":{"read":true}}},"super_user":false}`)
+	proposed := NewPermissionJSONValue(`{
+  "cluster_user": false,
+  "dogs": {"tables": {"breeds": {"delete": false, "read": true, "attribute_permissions": []}}}
+}`)
+
+	equal, diags := prior.StringSemanticEquals(context.Background(), proposed)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !equal {
+		t.Error("expected documents to be semantically equal")
+	}
+
+	equal, _ = prior.StringSemanticEquals(context.Background(), NewPermissionJSONValue(`{"dogs":{"tables":{"breeds":{"read":false}}}}`))
+	if equal {
+		t.Error("expected documents with different grants to differ")
+	}
+}
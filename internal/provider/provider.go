@@ -81,11 +81,32 @@ func (p *HarperDBProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewRoleResource,
 		NewTableResource,
 		NewUserResource,
+		NewRoleTablePermissionResource,
+		NewConfigurationResource,
+		NewRestartResource,
+		NewInstanceReadyResource,
+		NewClusterNodeResource,
+		NewClusterRoutesResource,
 	}
 }
 
 func (p *HarperDBProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewPermissionDocumentDataSource,
+		NewSchemaDataSource,
+		NewSchemasDataSource,
+		NewTableDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewRoleDataSource,
+		NewRolesDataSource,
+		NewSystemInformationDataSource,
+		NewSQLQueryDataSource,
+		NewRecordsDataSource,
+		NewConfigurationDataSource,
+		NewInstanceReadyDataSource,
+		NewClusterStatusDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
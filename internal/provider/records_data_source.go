@@ -0,0 +1,389 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Search types of search_by_conditions.
+var searchConditionTypes = []string{
+	"equals",
+	"contains",
+	"starts_with",
+	"ends_with",
+	"greater_than",
+	"greater_than_equal",
+	"less_than",
+	"less_than_equal",
+	"between",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RecordsDataSource{}
+var _ validator.String = jsonValidator{}
+
+func NewRecordsDataSource() datasource.DataSource {
+	return &RecordsDataSource{}
+}
+
+// RecordsDataSource defines the data source implementation.
+type RecordsDataSource struct {
+	client *harperdb.Client
+}
+
+// RecordsDataSourceModel describes the data source data model.
+type RecordsDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Schema          types.String `tfsdk:"schema"`
+	Table           types.String `tfsdk:"table"`
+	GetAttributes   types.List   `tfsdk:"get_attributes"`
+	HashValues      types.List   `tfsdk:"hash_values"`
+	SearchAttribute types.String `tfsdk:"search_attribute"`
+	SearchValue     types.String `tfsdk:"search_value"`
+	Conditions      types.List   `tfsdk:"conditions"`
+	Operator        types.String `tfsdk:"operator"`
+	Sort            types.Object `tfsdk:"sort"`
+	Limit           types.Int64  `tfsdk:"limit"`
+	Rows            types.List   `tfsdk:"rows"`
+	JSON            types.String `tfsdk:"json"`
+}
+
+// RecordsConditionModel describes a single entry of conditions.
+type RecordsConditionModel struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Type      types.String `tfsdk:"type"`
+	Value     types.String `tfsdk:"value"`
+}
+
+// RecordsSortModel describes sort.
+type RecordsSortModel struct {
+	Attribute  types.String `tfsdk:"attribute"`
+	Descending types.Bool   `tfsdk:"descending"`
+}
+
+func (d *RecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_records"
+}
+
+func (d *RecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Searches the records of a table by hash values, by the value of an attribute or by conditions. " +
+			"Exactly one of `hash_values`, `search_attribute` or `conditions` must be set. " +
+			"Search values are JSON encoded, for example `jsonencode(\"Penny\")` or `jsonencode(5)`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Hash of the search",
+				Computed:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Schema of the table",
+				Required:            true,
+			},
+			"table": schema.StringAttribute{
+				MarkdownDescription: "Name of the table",
+				Required:            true,
+			},
+			"get_attributes": schema.ListAttribute{
+				MarkdownDescription: "Attributes to return. Defaults to all attributes.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"hash_values": schema.ListAttribute{
+				MarkdownDescription: "JSON encoded hash values of the records to return (`search_by_hash`)",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(jsonValidator{}),
+					listvalidator.ExactlyOneOf(path.MatchRoot("search_attribute"), path.MatchRoot("conditions")),
+				},
+			},
+			"search_attribute": schema.StringAttribute{
+				MarkdownDescription: "Attribute to search by value (`search_by_value`)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("search_value")),
+				},
+			},
+			"search_value": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded value of `search_attribute`. Strings may contain `*` as wildcard.",
+				Optional:            true,
+				Validators: []validator.String{
+					jsonValidator{},
+					stringvalidator.AlsoRequires(path.MatchRoot("search_attribute")),
+				},
+			},
+			"conditions": schema.ListNestedAttribute{
+				MarkdownDescription: "Conditions the records must match (`search_by_conditions`)",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute": schema.StringAttribute{
+							MarkdownDescription: "Attribute to compare",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Comparison, one of `equals`, `contains`, `starts_with`, `ends_with`, `greater_than`, " +
+								"`greater_than_equal`, `less_than`, `less_than_equal` or `between`",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(searchConditionTypes...),
+							},
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "JSON encoded value to compare with, a two element array for `between`",
+							Required:            true,
+							Validators: []validator.String{
+								jsonValidator{},
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"operator": schema.StringAttribute{
+				MarkdownDescription: "Combination of `conditions`, `and` or `or`. Defaults to `and`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("and", "or"),
+					stringvalidator.AlsoRequires(path.MatchRoot("conditions")),
+				},
+			},
+			"sort": schema.SingleNestedAttribute{
+				MarkdownDescription: "Order of the records matching `conditions`",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"attribute": schema.StringAttribute{
+						MarkdownDescription: "Attribute to sort by",
+						Required:            true,
+					},
+					"descending": schema.BoolAttribute{
+						MarkdownDescription: "Sort in descending order",
+						Optional:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRoot("conditions")),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of records matching `conditions` to return",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("conditions")),
+				},
+			},
+			"rows": schema.ListAttribute{
+				MarkdownDescription: "JSON encoded records",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded result",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RecordsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op, diags := recordsOperation(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var raw json.RawMessage
+	if err := d.client.RawRequest(op, &raw); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search records, got error: %s", err))
+		return
+	}
+
+	rows, err := encodeRecords(raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode records, got error: %s", err))
+		return
+	}
+
+	rowsValue, diags := types.ListValueFrom(ctx, types.StringType, rows)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, err := json.Marshal(op.Prepare())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode search, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%x", sha256.Sum256(request)))
+	data.Rows = rowsValue
+	data.JSON = rawJSONValue(raw)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// recordsOperation builds the search operation of the configuration.
+func recordsOperation(ctx context.Context, data RecordsDataSourceModel) (harperdb.Operation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	getAttributes := []string{"*"}
+	if !data.GetAttributes.IsNull() {
+		diags.Append(data.GetAttributes.ElementsAs(ctx, &getAttributes, false)...)
+	}
+
+	schemaName, table := data.Schema.ValueString(), data.Table.ValueString()
+
+	switch {
+	case !data.HashValues.IsNull():
+		var hashValues []string
+		diags.Append(data.HashValues.ElementsAs(ctx, &hashValues, false)...)
+
+		op := opSearchByHash{Schema: schemaName, Table: table, GetAttributes: getAttributes}
+		for _, value := range hashValues {
+			op.HashValues = append(op.HashValues, json.RawMessage(value))
+		}
+		return op, diags
+	case !data.SearchAttribute.IsNull():
+		return opSearchByValue{
+			Schema:          schemaName,
+			Table:           table,
+			SearchAttribute: data.SearchAttribute.ValueString(),
+			SearchValue:     json.RawMessage(data.SearchValue.ValueString()),
+			GetAttributes:   getAttributes,
+		}, diags
+	default:
+		var conditions []RecordsConditionModel
+		diags.Append(data.Conditions.ElementsAs(ctx, &conditions, false)...)
+
+		op := opSearchByConditions{
+			Schema:        schemaName,
+			Table:         table,
+			Operator:      data.Operator.ValueString(),
+			Limit:         data.Limit.ValueInt64(),
+			GetAttributes: getAttributes,
+		}
+		for _, condition := range conditions {
+			op.Conditions = append(op.Conditions, searchCondition{
+				SearchAttribute: condition.Attribute.ValueString(),
+				SearchType:      condition.Type.ValueString(),
+				SearchValue:     json.RawMessage(condition.Value.ValueString()),
+			})
+		}
+
+		if !data.Sort.IsNull() {
+			var sort RecordsSortModel
+			diags.Append(data.Sort.As(ctx, &sort, basetypes.ObjectAsOptions{})...)
+
+			op.Sort = &searchSort{
+				Attribute:  sort.Attribute.ValueString(),
+				Descending: sort.Descending.ValueBool(),
+			}
+		}
+		return op, diags
+	}
+}
+
+// encodeRecords returns each record of a search result JSON encoded, with
+// sorted keys and numbers as returned by HarperDB.
+func encodeRecords(raw json.RawMessage) ([]string, error) {
+	var records []map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&records); err != nil {
+		return nil, err
+	}
+
+	rows := make([]string, 0, len(records))
+	for _, record := range records {
+		b, err := json.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, string(b))
+	}
+
+	return rows, nil
+}
+
+// jsonValidator ensures a string is valid JSON.
+type jsonValidator struct{}
+
+func (v jsonValidator) Description(ctx context.Context) string {
+	return "value must be valid JSON"
+}
+
+func (v jsonValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			"The value is not valid JSON. Use jsonencode to encode values, for example jsonencode(\"Penny\").",
+		)
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRecordsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_schema" "test" {
+  name = "tf_acc_records_ds"
+}
+
+resource "harperdb_table" "test" {
+  schema         = harperdb_schema.test.name
+  name           = "dogs"
+  hash_attribute = "id"
+}
+
+data "harperdb_records" "by_hash" {
+  schema      = harperdb_table.test.schema
+  table       = harperdb_table.test.name
+  hash_values = [jsonencode(1)]
+}
+
+data "harperdb_records" "by_value" {
+  schema           = harperdb_table.test.schema
+  table            = harperdb_table.test.name
+  search_attribute = "id"
+  search_value     = jsonencode("*")
+}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harperdb_records.by_hash", "rows.#", "0"),
+					resource.TestCheckResourceAttr("data.harperdb_records.by_value", "rows.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_records" "test" {
+  schema      = "dev"
+  table       = "dogs"
+  hash_values = ["Penny"]
+}
+`, testAccProviderTF()),
+				ExpectError: regexp.MustCompile("Invalid JSON"),
+			},
+		},
+	})
+}
+
+func TestRecordsOperation(t *testing.T) {
+	ctx := context.Background()
+	conditionType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"attribute": types.StringType,
+		"type":      types.StringType,
+		"value":     types.StringType,
+	}}
+	sortTypes := map[string]attr.Type{
+		"attribute":  types.StringType,
+		"descending": types.BoolType,
+	}
+
+	base := RecordsDataSourceModel{
+		Schema:        types.StringValue("dev"),
+		Table:         types.StringValue("dogs"),
+		GetAttributes: types.ListNull(types.StringType),
+		HashValues:    types.ListNull(types.StringType),
+		Conditions:    types.ListNull(conditionType),
+		Sort:          types.ObjectNull(sortTypes),
+	}
+
+	byHash := base
+	byHash.HashValues = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("1"), types.StringValue(`"a"`)})
+
+	byValue := base
+	byValue.GetAttributes = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("name")})
+	byValue.SearchAttribute = types.StringValue("name")
+	byValue.SearchValue = types.StringValue(`"Pen*"`)
+
+	byConditions := base
+	byConditions.Conditions = types.ListValueMust(conditionType, []attr.Value{
+		types.ObjectValueMust(conditionType.AttrTypes, map[string]attr.Value{
+			"attribute": types.StringValue("age"),
+			"type":      types.StringValue("between"),
+			"value":     types.StringValue("[1, 5]"),
+		}),
+	})
+	byConditions.Operator = types.StringValue("or")
+	byConditions.Limit = types.Int64Value(10)
+	byConditions.Sort = types.ObjectValueMust(sortTypes, map[string]attr.Value{
+		"attribute":  types.StringValue("age"),
+		"descending": types.BoolNull(),
+	})
+
+	testCases := map[string]struct {
+		data RecordsDataSourceModel
+		want string
+	}{
+		"search_by_hash": {
+			data: byHash,
+			want: `{"operation":"search_by_hash","schema":"dev","table":"dogs","hash_values":[1,"a"],"get_attributes":["*"]}`,
+		},
+		"search_by_value": {
+			data: byValue,
+			want: `{"operation":"search_by_value","schema":"dev","table":"dogs","search_attribute":"name","search_value":"Pen*","get_attributes":["name"]}`,
+		},
+		"search_by_conditions": {
+			data: byConditions,
+			want: `{"operation":"search_by_conditions","schema":"dev","table":"dogs","operator":"or","limit":10,"sort":{"attribute":"age","descending":false},` +
+				`"get_attributes":["*"],"conditions":[{"search_attribute":"age","search_type":"between","search_value":[1,5]}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			op, diags := recordsOperation(ctx, testCase.data)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			b, err := json.Marshal(op.Prepare())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(b) != testCase.want {
+				t.Errorf("expected %s, got %s", testCase.want, b)
+			}
+		})
+	}
+}
+
+func TestEncodeRecords(t *testing.T) {
+	rows, err := encodeRecords([]byte(`[{"name": "Penny", "id": 12345678901234567890, "tags": ["a"]}]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"id":12345678901234567890,"name":"Penny","tags":["a"]}`
+	if len(rows) != 1 || rows[0] != want {
+		t.Errorf("expected [%s], got %v", want, rows)
+	}
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/go-resty/resty/v2"
+)
+
+// restartInstance is the restart value restarting all of HarperDB rather
+// than a single service.
+const restartInstance = "instance"
+
+// Services which can be restarted with restart_service.
+var restartServices = []string{
+	"http_workers",
+	"clustering",
+	"custom_functions",
+}
+
+// defaultRestartTimeout is how long to wait for the instance to become
+// healthy after a restart.
+const defaultRestartTimeout = 5 * time.Minute
+
+// HarperDB acknowledges a restart before it shuts down. Polling starts after
+// restartGracePeriod so the old process is not mistaken for the restarted
+// one. They are variables to speed up tests.
+var (
+	restartGracePeriod  = 5 * time.Second
+	restartPollInterval = 2 * time.Second
+)
+
+// pollRequestTimeout bounds each system_information request while polling.
+// A request never outlives the timeout of the poll either.
+var pollRequestTimeout = 30 * time.Second
+
+// restart restarts HarperDB, or a single service of it, and waits until the
+// instance answers system_information again.
+func restart(ctx context.Context, client *harperdb.Client, target string, timeout time.Duration) error {
+	var op harperdb.Operation = opRestartService{Service: target}
+	if target == restartInstance {
+		op = opRestart{}
+	}
+
+	if err := client.RawRequest(op, nil); err != nil {
+		return fmt.Errorf("unable to restart %s: %w", target, err)
+	}
+
+	return waitForHealthy(ctx, client, timeout)
+}
+
+// waitForHealthy polls system_information until it succeeds or timeout
+// expires.
+func waitForHealthy(ctx context.Context, client *harperdb.Client, timeout time.Duration) error {
+	_, err := pollSystemInformation(ctx, client, timeout, func(attempt int) time.Duration {
+		if attempt == 0 {
+			return restartGracePeriod
+		}
+		return restartPollInterval
+	})
+
+	return err
+}
+
+// pollSystemInformation requests the system section of system_information
+// until it succeeds or timeout expires. Before each attempt it waits for the
+// delay returned by next, which is given the number of the attempt starting
+// at 0. timeout includes requests which never complete, see timeoutClient.
+func pollSystemInformation(ctx context.Context, client *harperdb.Client, timeout time.Duration, next func(attempt int) time.Duration) (*systemInformationResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			if err == nil {
+				return nil, fmt.Errorf("instance not healthy after %s", timeout)
+			}
+			return nil, fmt.Errorf("instance not healthy after %s, last error: %w", timeout, err)
+		case <-time.After(next(attempt)):
+		}
+
+		deadline, _ := ctx.Deadline()
+		attemptTimeout := time.Until(deadline)
+		if attemptTimeout > pollRequestTimeout {
+			attemptTimeout = pollRequestTimeout
+		}
+
+		var info systemInformationResponse
+		err = timeoutClient(client, attemptTimeout).RawRequest(opSystemInformation{Attributes: []string{"system"}}, &info)
+		if err == nil {
+			return &info, nil
+		}
+	}
+}
+
+// timeoutClient returns a copy of client whose requests time out after
+// timeout. The SDK neither takes a context nor sets a timeout, so a hung
+// request would otherwise block the caller indefinitely.
+func timeoutClient(client *harperdb.Client, timeout time.Duration) *harperdb.Client {
+	httpClient := *client.HttpClient.GetClient()
+	httpClient.Timeout = timeout
+
+	restyClient := resty.NewWithClient(&httpClient)
+	restyClient.UserInfo = client.HttpClient.UserInfo
+	restyClient.Header = client.HttpClient.Header.Clone()
+	restyClient.SetDisableWarn(true)
+
+	copied := *client
+	copied.HttpClient = restyClient
+
+	return &copied
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RestartResource{}
+var _ validator.String = durationValidator{}
+
+func NewRestartResource() resource.Resource {
+	return &RestartResource{}
+}
+
+// RestartResource defines the resource implementation.
+// It restarts the instance when it is created, that is whenever a trigger
+// changes. It exists in the Terraform state only.
+type RestartResource struct {
+	client *harperdb.Client
+}
+
+// RestartResourceModel describes the resource data model.
+type RestartResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	Service     types.String `tfsdk:"service"`
+	Timeout     types.String `tfsdk:"timeout"`
+	RestartedAt types.String `tfsdk:"restarted_at"`
+}
+
+func (r *RestartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restart"
+}
+
+func (r *RestartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Restarts HarperDB, or one of its services, once whenever any of `triggers` changes " +
+			"and waits until the instance is healthy again. Use it to apply several changes which require a restart with a single one.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Time of the restart",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values whose change causes a restart, for example IDs or hashes of the resources requiring it",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Service to restart, one of `" + strings.Join(restartServices, "`, `") + "`. By default HarperDB is restarted.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(restartServices...),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long to wait for the instance to become healthy, for example `10m`. Defaults to `%s`.", defaultRestartTimeout),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultRestartTimeout.String()),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"restarted_at": schema.StringAttribute{
+				MarkdownDescription: "Time the instance was healthy again in RFC 3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RestartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RestartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RestartResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The value has been validated by durationValidator.
+	timeout, _ := time.ParseDuration(data.Timeout.ValueString())
+
+	target := restartInstance
+	if !data.Service.IsNull() {
+		target = data.Service.ValueString()
+	}
+
+	if err := restart(ctx, r.client, target, timeout); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restart, got error: %s", err))
+		return
+	}
+
+	restartedAt := time.Now().UTC().Format(time.RFC3339)
+	data.ID = types.StringValue(restartedAt)
+	data.RestartedAt = types.StringValue(restartedAt)
+
+	tflog.Trace(ctx, "restarted the instance", map[string]interface{}{"target": target})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RestartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A restart has no remote state.
+}
+
+func (r *RestartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RestartResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only service and timeout can change in place, they apply to the next
+	// restart.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RestartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing a restart from the state does not affect the instance.
+}
+
+// durationValidator ensures a string is a positive duration as accepted by
+// time.ParseDuration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as 5m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The value must be a positive duration such as 5m or 90s, got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}
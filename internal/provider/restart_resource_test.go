@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRestartResource(t *testing.T) {
+	var restartedAt string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestartResourceConfig("one", "5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_restart.test", "service", "http_workers"),
+					resource.TestCheckResourceAttrWith("harperdb_restart.test", "restarted_at", func(value string) error {
+						restartedAt = value
+						return nil
+					}),
+				),
+			},
+			// Changing the timeout does not restart.
+			{
+				Config: testAccRestartResourceConfig("one", "10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_restart.test", "timeout", "10m"),
+					resource.TestCheckResourceAttrWith("harperdb_restart.test", "restarted_at", func(value string) error {
+						if value != restartedAt {
+							return fmt.Errorf("expected no restart, restarted at %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccRestartResourceConfig("two", "10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_restart.test", "triggers.config", "two"),
+				),
+			},
+			{
+				Config:      testAccRestartResourceConfig("two", "soon"),
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+		},
+	})
+}
+
+func testAccRestartResourceConfig(trigger, timeout string) string {
+	return fmt.Sprintf(`
+	%s
+
+resource "harperdb_restart" "test" {
+  triggers = {
+    config = %q
+  }
+  service = "http_workers"
+  timeout = %q
+}
+`, testAccProviderTF(), trigger, timeout)
+}
+
+func TestDurationValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value     types.String
+		wantError bool
+	}{
+		"null":     {value: types.StringNull()},
+		"unknown":  {value: types.StringUnknown()},
+		"valid":    {value: types.StringValue("1m30s")},
+		"zero":     {value: types.StringValue("0s"), wantError: true},
+		"negative": {value: types.StringValue("-5m"), wantError: true},
+		"invalid":  {value: types.StringValue("5 minutes"), wantError: true},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			durationValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("timeout"),
+				ConfigValue: tc.value,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+)
+
+// testInstanceServer answers restart operations and fails system_information
+// until unhealthy requests have been made. It shortens the delays between
+// polls.
+func testInstanceServer(t *testing.T, unhealthy int) (*harperdb.Client, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var operations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Operation string `json:"operation"`
+			Service   string `json:"service"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected request body: %s", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		operations = append(operations, body.Operation+body.Service)
+
+		w.Header().Set("Content-Type", "application/json")
+		if body.Operation == harperdb.OP_SYSTEM_INFORMATION && unhealthy > 0 {
+			unhealthy--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error": "starting"}`))
+			return
+		}
+		if body.Operation == harperdb.OP_SYSTEM_INFORMATION {
+			w.Write([]byte(`{"system": {"hdb_version": "4.1.0"}}`))
+			return
+		}
+		w.Write([]byte(`{"message": "ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	gracePeriod, pollInterval := restartGracePeriod, restartPollInterval
+	restartGracePeriod, restartPollInterval = time.Millisecond, time.Millisecond
+	minBackoff, maxBackoff := readyMinBackoff, readyMaxBackoff
+	readyMinBackoff, readyMaxBackoff = time.Millisecond, 4*time.Millisecond
+	t.Cleanup(func() {
+		restartGracePeriod, restartPollInterval = gracePeriod, pollInterval
+		readyMinBackoff, readyMaxBackoff = minBackoff, maxBackoff
+	})
+
+	return harperdb.NewClient(server.URL, "user", "password"), func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), operations...)
+	}
+}
+
+func TestRestart(t *testing.T) {
+	client, operations := testInstanceServer(t, 2)
+
+	if err := restart(context.Background(), client, restartInstance, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{"restart", "system_information", "system_information", "system_information"}
+	if got := operations(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected operations %v, got %v", want, got)
+	}
+}
+
+func TestRestart_service(t *testing.T) {
+	client, operations := testInstanceServer(t, 0)
+
+	if err := restart(context.Background(), client, "http_workers", time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := operations(); len(got) != 2 || got[0] != "restart_servicehttp_workers" {
+		t.Errorf("expected restart_service of http_workers, got %v", got)
+	}
+}
+
+func TestRestart_timeout(t *testing.T) {
+	client, _ := testInstanceServer(t, 1<<30)
+
+	if err := restart(context.Background(), client, restartInstance, 50*time.Millisecond); err == nil {
+		t.Fatal("expected an error for an instance which never becomes healthy")
+	}
+}
+
+// TestWaitForHealthy_hangingRequest ensures that a request which never
+// completes does not outlast the timeout.
+func TestWaitForHealthy_hangingRequest(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	gracePeriod := restartGracePeriod
+	restartGracePeriod = 0
+	t.Cleanup(func() { restartGracePeriod = gracePeriod })
+
+	client := harperdb.NewClient(server.URL, "user", "password")
+
+	start := time.Now()
+	if err := waitForHealthy(context.Background(), client, 50*time.Millisecond); err == nil {
+		t.Fatal("expected an error for an instance which never responds")
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected to give up after the timeout, took %s", elapsed)
+	}
+}
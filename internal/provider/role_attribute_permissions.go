@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ validator.Set = uniqueAttributeNamesValidator{}
+
+// attributePermissionsType is the attribute_permissions type used by the
+// harperdb_role schema. As a set its entries are compared regardless of
+// order, the flags HarperDB omits for an attribute decode as false.
+var attributePermissionsType = types.SetType{
+	ElemType: types.ObjectType{AttrTypes: roleAttributePermissionAttrTypes},
+}
+
+// attributePermissionsSchema returns the attribute_permissions attribute shared
+// by harperdb_role tables and harperdb_role_table_permission.
+func attributePermissionsSchema() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "Attribute level permissions, at most one entry per attribute name",
+		Optional:            true,
+		Validators: []validator.Set{
+			uniqueAttributeNamesValidator{},
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required: true,
+				},
+				"read": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+				"insert": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+				"update": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
+
+// attributePermissionsByName returns the attribute permissions of value keyed
+// by attribute name. Entries repeating a name are expected to be identical,
+// the last one wins.
+func attributePermissionsByName(ctx context.Context, value types.Set) (map[string]RoleAttributePermissionModel, diag.Diagnostics) {
+	var models []RoleAttributePermissionModel
+	diags := value.ElementsAs(ctx, &models, false)
+
+	result := make(map[string]RoleAttributePermissionModel, len(models))
+	for _, model := range models {
+		result[model.Name.ValueString()] = model
+	}
+
+	return result, diags
+}
+
+// uniqueAttributeNamesValidator rejects attribute_permissions which list the
+// same attribute name more than once.
+type uniqueAttributeNamesValidator struct{}
+
+func (v uniqueAttributeNamesValidator) Description(ctx context.Context) string {
+	return "attribute names must be unique"
+}
+
+func (v uniqueAttributeNamesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueAttributeNamesValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var models []RoleAttributePermissionModel
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &models, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, model := range models {
+		// Names referencing other resources are checked once known.
+		if model.Name.IsUnknown() || model.Name.IsNull() {
+			continue
+		}
+
+		name := model.Name.ValueString()
+		if seen[name] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Duplicate Attribute Permission",
+				fmt.Sprintf("The attribute %q is listed more than once, each attribute may only have a single permission entry per table.", name),
+			)
+		}
+		seen[name] = true
+	}
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testAttributePermissionsValue(t *testing.T, models ...RoleAttributePermissionModel) types.Set {
+	t.Helper()
+
+	v, diags := types.SetValueFrom(context.Background(), attributePermissionsType.ElemType, models)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	return v
+}
+
+func testAttributePermission(name string, read, insert, update bool) RoleAttributePermissionModel {
+	return RoleAttributePermissionModel{
+		Name:   types.StringValue(name),
+		Read:   types.BoolValue(read),
+		Insert: types.BoolValue(insert),
+		Update: types.BoolValue(update),
+	}
+}
+
+func TestAttributePermissionsEqual(t *testing.T) {
+	testCases := map[string]struct {
+		config []RoleAttributePermissionModel
+		server []harperdb.AttributePermissions
+		want   bool
+	}{
+		"reordered": {
+			config: []RoleAttributePermissionModel{testAttributePermission("a", true, false, false), testAttributePermission("b", false, true, false)},
+			server: []harperdb.AttributePermissions{{AttributeName: "b", Insert: true}, {AttributeName: "a", Read: true}},
+			want:   true,
+		},
+		"different-flags": {
+			config: []RoleAttributePermissionModel{testAttributePermission("a", true, false, false)},
+			server: []harperdb.AttributePermissions{{AttributeName: "a"}},
+		},
+		"missing-attribute": {
+			config: []RoleAttributePermissionModel{testAttributePermission("a", true, false, false), testAttributePermission("b", true, false, false)},
+			server: []harperdb.AttributePermissions{{AttributeName: "a", Read: true}},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			flattened, diags := flattenAttributePermissions(context.Background(), tc.server)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got := testAttributePermissionsValue(t, tc.config...).Equal(flattened); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUniqueAttributeNamesValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value     types.Set
+		wantError bool
+	}{
+		"unique": {
+			value: testAttributePermissionsValue(t, testAttributePermission("a", true, false, false), testAttributePermission("b", true, false, false)),
+		},
+		"duplicate": {
+			value:     testAttributePermissionsValue(t, testAttributePermission("a", true, false, false), testAttributePermission("a", false, false, false)),
+			wantError: true,
+		},
+		"unknown-name": {
+			value: testAttributePermissionsValue(t, testAttributePermission("a", true, false, false), RoleAttributePermissionModel{
+				Name:   types.StringUnknown(),
+				Read:   types.BoolValue(false),
+				Insert: types.BoolValue(false),
+				Update: types.BoolValue(false),
+			}),
+		},
+		"null": {
+			value: types.SetNull(attributePermissionsType.ElemType),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			req := validator.SetRequest{
+				Path:        path.Root("attribute_permissions"),
+				ConfigValue: tc.value,
+			}
+			resp := &validator.SetResponse{}
+
+			uniqueAttributeNamesValidator{}.ValidateSet(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tc.wantError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
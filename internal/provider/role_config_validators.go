@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.ConfigValidator = roleFlagsValidator{}
+var _ resource.ConfigValidator = roleTableGrantsValidator{}
+
+// roleConfigPermission is the permission configured for a role, regardless of
+// whether it is given through schema_permissions or permission_json.
+type roleConfigPermission struct {
+	superUser   bool
+	clusterUser bool
+	schemas     map[string]harperdb.SchemaPermission
+	fromJSON    bool
+}
+
+// readRoleConfigPermission decodes the permission of a harperdb_role
+// configuration. It returns nil while the role flags or permission_json are
+// unknown, or when the permission cannot be decoded, the latter being
+// reported by the attribute validators. Schemas and tables of
+// schema_permissions with unknown values, such as table names referencing
+// other resources, are left out so the rest can still be validated.
+func readRoleConfigPermission(ctx context.Context, config tfsdk.Config) *roleConfigPermission {
+	var data RoleResourceModel
+	if diags := config.Get(ctx, &data); diags.HasError() {
+		return nil
+	}
+
+	if !allKnown(data.SuperUser, data.ClusterUser, data.StructureUser, data.PermissionJSON) {
+		return nil
+	}
+
+	if !data.PermissionJSON.IsNull() {
+		perm, err := parsePermissionJSON(data.PermissionJSON.ValueString())
+		if err != nil {
+			return nil
+		}
+
+		schemas := map[string]harperdb.SchemaPermission{}
+		for key, value := range perm {
+			if sp, ok := value.(harperdb.SchemaPermission); ok {
+				schemas[key] = sp
+			}
+		}
+
+		return &roleConfigPermission{
+			superUser:   permissionFlag(perm, permissionKeySuperUser),
+			clusterUser: permissionFlag(perm, permissionKeyClusterUser),
+			schemas:     schemas,
+			fromJSON:    true,
+		}
+	}
+
+	return &roleConfigPermission{
+		superUser:   data.SuperUser.ValueBool(),
+		clusterUser: data.ClusterUser.ValueBool(),
+		schemas:     knownSchemaPermissions(ctx, data.SchemaPermissions),
+	}
+}
+
+// knownSchemaPermissions expands the known parts of schema_permissions.
+// Tables are expanded one by one, those with unknown or invalid values are
+// skipped, as are default_table_permissions which are not known yet.
+func knownSchemaPermissions(ctx context.Context, value types.Map) map[string]harperdb.SchemaPermission {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	var models map[string]RoleSchemaPermissionModel
+	if diags := value.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil
+	}
+
+	schemas := make(map[string]harperdb.SchemaPermission, len(models))
+	for name, model := range models {
+		p := path.Root("schema_permissions").AtMapKey(name)
+
+		tables := map[string]harperdb.TablePermission{}
+		if !model.Tables.IsNull() && !model.Tables.IsUnknown() {
+			for table, element := range model.Tables.Elements() {
+				single, d := types.MapValue(model.Tables.ElementType(ctx), map[string]attr.Value{table: element})
+				if d.HasError() {
+					continue
+				}
+
+				expanded, known, d := expandTablePermissions(ctx, p.AtName("tables"), single)
+				if known && !d.HasError() {
+					tables[table] = expanded[table]
+				}
+			}
+		}
+
+		defaults, expanded, known, d := expandDefaultTables(ctx, p, model)
+		if known && !d.HasError() {
+			for _, table := range expanded {
+				if _, ok := tables[table]; !ok {
+					tables[table] = defaults
+				}
+			}
+		}
+
+		schemas[name] = harperdb.SchemaPermission{Tables: tables}
+	}
+
+	return schemas
+}
+
+// flagPath returns the path diagnostics about a role flag are attached to.
+func (p *roleConfigPermission) flagPath(key string) path.Path {
+	if p.fromJSON {
+		return path.Root("permission_json")
+	}
+
+	return path.Root(key)
+}
+
+// tablePath returns the path diagnostics about a table grant are attached to.
+func (p *roleConfigPermission) tablePath(schemaName, table string) path.Path {
+	if p.fromJSON {
+		return path.Root("permission_json")
+	}
+
+	return path.Root("schema_permissions").AtMapKey(schemaName).AtName("tables").AtMapKey(table)
+}
+
+// validateFlags reports contradictory role flag combinations.
+func (p *roleConfigPermission) validateFlags() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if p.superUser && p.clusterUser {
+		diags.AddAttributeError(
+			p.flagPath(permissionKeyClusterUser),
+			"Conflicting Role Flags",
+			"A role cannot be both a super user and a cluster user, HarperDB rejects such roles. Set only one of super_user and cluster_user.",
+		)
+	}
+
+	if p.superUser && len(p.schemas) > 0 {
+		diags.AddAttributeError(
+			p.flagPath(permissionKeySuperUser),
+			"Conflicting Role Permissions",
+			"Super users have full access to every schema, HarperDB ignores the schema permissions of such roles. "+
+				"Remove the schema permissions or set super_user to false.",
+		)
+	}
+
+	return diags
+}
+
+// validateTableGrants reports attribute permissions which are not backed by
+// the grant of their table.
+func (p *roleConfigPermission) validateTableGrants() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for schemaName, sp := range p.schemas {
+		for table, tp := range sp.Tables {
+			tablePath := p.tablePath(schemaName, table)
+
+			for _, ap := range tp.AttributePermissions {
+				if ap.Insert && !tp.Insert {
+					diags.AddAttributeError(
+						tablePath,
+						"Ineffective Attribute Permission",
+						fmt.Sprintf("The attribute %q of %s.%s grants insert, but the table denies it. Grant insert on the table or deny it on the attribute.", ap.AttributeName, schemaName, table),
+					)
+				}
+
+				if ap.Update && !tp.Update {
+					diags.AddAttributeError(
+						tablePath,
+						"Ineffective Attribute Permission",
+						fmt.Sprintf("The attribute %q of %s.%s grants update, but the table denies it. Grant update on the table or deny it on the attribute.", ap.AttributeName, schemaName, table),
+					)
+				}
+
+				if ap.Read && !tp.Read {
+					diags.AddAttributeWarning(
+						tablePath,
+						"Ineffective Attribute Permission",
+						fmt.Sprintf("The attribute %q of %s.%s grants read, but the table denies it, so the attribute cannot be read.", ap.AttributeName, schemaName, table),
+					)
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+// roleFlagsValidator rejects contradictory combinations of super_user,
+// cluster_user and schema permissions.
+type roleFlagsValidator struct{}
+
+func (v roleFlagsValidator) Description(ctx context.Context) string {
+	return "super_user cannot be combined with cluster_user or schema permissions"
+}
+
+func (v roleFlagsValidator) MarkdownDescription(ctx context.Context) string {
+	return "`super_user` cannot be combined with `cluster_user` or schema permissions"
+}
+
+func (v roleFlagsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if perm := readRoleConfigPermission(ctx, req.Config); perm != nil {
+		resp.Diagnostics.Append(perm.validateFlags()...)
+	}
+}
+
+// roleTableGrantsValidator checks attribute permissions against the grants
+// of their table.
+type roleTableGrantsValidator struct{}
+
+func (v roleTableGrantsValidator) Description(ctx context.Context) string {
+	return "attribute permissions must be granted by their table"
+}
+
+func (v roleTableGrantsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v roleTableGrantsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if perm := readRoleConfigPermission(ctx, req.Config); perm != nil {
+		resp.Diagnostics.Append(perm.validateTableGrants()...)
+	}
+}
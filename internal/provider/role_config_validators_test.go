@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRoleConfigPermissionValidateFlags(t *testing.T) {
+	schemas := map[string]harperdb.SchemaPermission{
+		"dogs": {Tables: map[string]harperdb.TablePermission{"breeds": {Read: true}}},
+	}
+
+	testCases := map[string]struct {
+		perm       roleConfigPermission
+		wantErrors int
+	}{
+		"super-user": {
+			perm: roleConfigPermission{superUser: true},
+		},
+		"cluster-user-with-schemas": {
+			perm: roleConfigPermission{clusterUser: true, schemas: schemas},
+		},
+		"super-and-cluster-user": {
+			perm:       roleConfigPermission{superUser: true, clusterUser: true},
+			wantErrors: 1,
+		},
+		"super-user-with-schemas": {
+			perm:       roleConfigPermission{superUser: true, schemas: schemas},
+			wantErrors: 1,
+		},
+		"super-user-with-schemas-json": {
+			perm:       roleConfigPermission{superUser: true, clusterUser: true, schemas: schemas, fromJSON: true},
+			wantErrors: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			diags := tc.perm.validateFlags()
+
+			if got := diags.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("expected %d errors, got: %v", tc.wantErrors, diags)
+			}
+		})
+	}
+}
+
+func TestRoleConfigPermissionValidateTableGrants(t *testing.T) {
+	testCases := map[string]struct {
+		table        harperdb.TablePermission
+		wantErrors   int
+		wantWarnings int
+	}{
+		"backed": {
+			table: harperdb.TablePermission{Read: true, Insert: true, AttributePermissions: []harperdb.AttributePermissions{
+				{AttributeName: "name", Read: true, Insert: true},
+			}},
+		},
+		"restricted": {
+			table: harperdb.TablePermission{Read: true, Insert: true, Update: true, AttributePermissions: []harperdb.AttributePermissions{
+				{AttributeName: "name"},
+			}},
+		},
+		"insert-and-update-denied": {
+			table: harperdb.TablePermission{Read: true, AttributePermissions: []harperdb.AttributePermissions{
+				{AttributeName: "name", Read: true, Insert: true, Update: true},
+			}},
+			wantErrors: 2,
+		},
+		"read-denied": {
+			table: harperdb.TablePermission{Insert: true, AttributePermissions: []harperdb.AttributePermissions{
+				{AttributeName: "name", Read: true, Insert: true},
+			}},
+			wantWarnings: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			perm := roleConfigPermission{
+				schemas: map[string]harperdb.SchemaPermission{
+					"dogs": {Tables: map[string]harperdb.TablePermission{"breeds": tc.table}},
+				},
+			}
+
+			diags := perm.validateTableGrants()
+
+			if got := diags.ErrorsCount(); got != tc.wantErrors {
+				t.Errorf("expected %d errors, got: %v", tc.wantErrors, diags)
+			}
+
+			if got := diags.WarningsCount(); got != tc.wantWarnings {
+				t.Errorf("expected %d warnings, got: %v", tc.wantWarnings, diags)
+			}
+		})
+	}
+}
+
+// testRoleConfig returns a harperdb_role configuration with the given
+// attributes, all others being null.
+func testRoleConfig(t *testing.T, attributes func(types map[string]tftypes.Type) map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	(&RoleResource{}).Schema(context.Background(), resource.SchemaRequest{}, &resp)
+
+	objectType := resp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes(objectType.AttributeTypes) {
+		values[name] = value
+	}
+
+	return tfsdk.Config{Raw: tftypes.NewValue(objectType, values), Schema: resp.Schema}
+}
+
+func TestRoleFlagsValidator_unknownTables(t *testing.T) {
+	// The tables of dogs reference another resource and are unknown.
+	config := testRoleConfig(t, func(types map[string]tftypes.Type) map[string]tftypes.Value {
+		schemaType := types["schema_permissions"].(tftypes.Map).ElementType.(tftypes.Object)
+		schemaValues := map[string]tftypes.Value{}
+		for name, attributeType := range schemaType.AttributeTypes {
+			schemaValues[name] = tftypes.NewValue(attributeType, nil)
+		}
+		schemaValues["tables"] = tftypes.NewValue(schemaType.AttributeTypes["tables"], tftypes.UnknownValue)
+
+		return map[string]tftypes.Value{
+			"super_user":   tftypes.NewValue(tftypes.Bool, true),
+			"cluster_user": tftypes.NewValue(tftypes.Bool, true),
+			"schema_permissions": tftypes.NewValue(types["schema_permissions"], map[string]tftypes.Value{
+				"dogs": tftypes.NewValue(schemaType, schemaValues),
+			}),
+		}
+	})
+
+	var resp resource.ValidateConfigResponse
+	roleFlagsValidator{}.ValidateResource(context.Background(), resource.ValidateConfigRequest{Config: config}, &resp)
+
+	// Both super_user with cluster_user and super_user with schema
+	// permissions are reported.
+	if got := resp.Diagnostics.ErrorsCount(); got != 2 {
+		t.Errorf("expected 2 errors, got: %v", resp.Diagnostics)
+	}
+
+	resp = resource.ValidateConfigResponse{}
+	roleTableGrantsValidator{}.ValidateResource(context.Background(), resource.ValidateConfigRequest{Config: config}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("expected no table grant errors, got: %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RoleDataSource{}
+
+func NewRoleDataSource() datasource.DataSource {
+	return &RoleDataSource{}
+}
+
+// RoleDataSource defines the data source implementation.
+type RoleDataSource struct {
+	client *harperdb.Client
+}
+
+// RoleDataSourceModel describes the data source data model, it is shared with
+// the entries of harperdb_roles.
+type RoleDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	SuperUser            types.Bool   `tfsdk:"super_user"`
+	ClusterUser          types.Bool   `tfsdk:"cluster_user"`
+	StructureUser        types.Bool   `tfsdk:"structure_user"`
+	StructureUserSchemas types.Set    `tfsdk:"structure_user_schemas"`
+	SchemaPermissions    types.Map    `tfsdk:"schema_permissions"`
+	PermissionJSON       types.String `tfsdk:"permission_json"`
+}
+
+var roleDataSourceAttrTypes = map[string]attr.Type{
+	"id":                     types.StringType,
+	"name":                   types.StringType,
+	"super_user":             types.BoolType,
+	"cluster_user":           types.BoolType,
+	"structure_user":         types.BoolType,
+	"structure_user_schemas": types.SetType{ElemType: types.StringType},
+	"schema_permissions":     types.MapType{ElemType: types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}},
+	"permission_json":        types.StringType,
+}
+
+// roleDataSourceAttributes returns the attributes describing a role. The
+// lookup attributes id and name are optional when lookup is set.
+func roleDataSourceAttributes(lookup bool) map[string]schema.Attribute {
+	var lookupValidators []validator.String
+	if lookup {
+		lookupValidators = []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+		}
+	}
+
+	grant := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the Role",
+			Optional:            lookup,
+			Computed:            true,
+			Validators:          lookupValidators,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the Role",
+			Optional:            lookup,
+			Computed:            true,
+			Validators:          lookupValidators,
+		},
+		"super_user":     grant("Is super user"),
+		"cluster_user":   grant("Is cluster user"),
+		"structure_user": grant("Allows creating and dropping schemas and tables in every schema"),
+		"structure_user_schemas": schema.SetAttribute{
+			MarkdownDescription: "Schemas in which tables may be created and dropped",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"schema_permissions": schema.MapNestedAttribute{
+			MarkdownDescription: "Permissions per schema, in the shape of the `harperdb_role` resource",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"tables": schema.MapNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"read":   grant(""),
+								"insert": grant(""),
+								"update": grant(""),
+								"delete": grant(""),
+								"attribute_permissions": schema.SetNestedAttribute{
+									MarkdownDescription: "Attribute level permissions",
+									Computed:            true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"name": schema.StringAttribute{
+												Computed: true,
+											},
+											"read":   grant(""),
+											"insert": grant(""),
+											"update": grant(""),
+										},
+									},
+								},
+								"attribute_mode": schema.StringAttribute{
+									MarkdownDescription: "Always `inherit`, attributes denied explicitly are listed in `attribute_permissions`",
+									Computed:            true,
+								},
+								"denied_attributes": schema.SetAttribute{
+									MarkdownDescription: "Always null, see `attribute_mode`",
+									Computed:            true,
+									ElementType:         types.StringType,
+								},
+							},
+						},
+					},
+					"default_table_permissions": schema.SingleNestedAttribute{
+						MarkdownDescription: "Always null, HarperDB stores the expanded grants in `tables`",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"read":   grant(""),
+							"insert": grant(""),
+							"update": grant(""),
+							"delete": grant(""),
+						},
+					},
+					"expanded_tables": schema.SetAttribute{
+						MarkdownDescription: "Always null, see `default_table_permissions`",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+		"permission_json": schema.StringAttribute{
+			MarkdownDescription: "Normalized native HarperDB permission document of the role",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *RoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *RoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a role by name or ID, including the built-in `super_user` and `cluster_user` roles",
+
+		Attributes: roleDataSourceAttributes(true),
+	}
+}
+
+func (d *RoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoleDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name is only matched against role names and the ID against IDs, a
+	// role named like the ID of another one must not shadow it.
+	var role *harperdb.Role
+	var err error
+	if data.ID.IsNull() {
+		role, err = findRoleByName(d.client, data.Name.ValueString())
+	} else {
+		role, err = findRole(d.client, data.ID.ValueString(), false)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return
+	}
+
+	if role == nil {
+		lookup := fmt.Sprintf("ID %q", data.ID.ValueString())
+		if data.ID.IsNull() {
+			lookup = fmt.Sprintf("name %q", data.Name.ValueString())
+		}
+		resp.Diagnostics.AddError("Role Not Found", fmt.Sprintf("No role with the %s exists.", lookup))
+		return
+	}
+
+	data, diags := flattenRole(ctx, *role)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenRole converts a role as returned by list_roles into the data source
+// model.
+func flattenRole(ctx context.Context, role harperdb.Role) (RoleDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := RoleDataSourceModel{
+		ID:                   types.StringValue(role.ID),
+		Name:                 types.StringValue(role.Role),
+		SuperUser:            types.BoolValue(permissionFlag(role.Permission, permissionKeySuperUser)),
+		ClusterUser:          types.BoolValue(permissionFlag(role.Permission, permissionKeyClusterUser)),
+		StructureUserSchemas: types.SetNull(types.StringType),
+		SchemaPermissions:    types.MapNull(types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}),
+	}
+
+	structureAll, structureSchemas, err := structureUser(role.Permission[permissionKeyStructureUser])
+	if err != nil {
+		diags.AddError("Unexpected Role Permission", fmt.Sprintf("Unable to decode the permissions of role %s, got error: %s", role.Role, err))
+		return data, diags
+	}
+
+	data.StructureUser = types.BoolValue(structureAll)
+	if len(structureSchemas) > 0 {
+		var d diag.Diagnostics
+		data.StructureUserSchemas, d = types.SetValueFrom(ctx, types.StringType, structureSchemas)
+		diags.Append(d...)
+	}
+
+	schemas, err := schemaPermissions(role.Permission)
+	if err != nil {
+		diags.AddError("Unexpected Role Permission", fmt.Sprintf("Unable to decode the permissions of role %s, got error: %s", role.Role, err))
+		return data, diags
+	}
+
+	var d diag.Diagnostics
+	data.SchemaPermissions, d = flattenSchemaPermissions(ctx, schemas, types.MapNull(types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}))
+	diags.Append(d...)
+
+	document, err := normalizeRolePermission(role.Permission)
+	if err != nil {
+		diags.AddError("Unexpected Role Permission", fmt.Sprintf("Unable to decode the permissions of role %s, got error: %s", role.Role, err))
+		return data, diags
+	}
+	data.PermissionJSON = types.StringValue(document)
+
+	return data, diags
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceConfig("tf_acc_role_ds", true) + `
+data "harperdb_role" "by_name" {
+  name = harperdb_role.test.name
+}
+
+data "harperdb_role" "by_id" {
+  id = harperdb_role.test.id
+}
+
+data "harperdb_role" "super_user" {
+  name = "super_user"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.harperdb_role.by_name", "id", "harperdb_role.test", "id"),
+					resource.TestCheckResourceAttr("data.harperdb_role.by_id", "name", "tf_acc_role_ds"),
+					resource.TestCheckResourceAttr("data.harperdb_role.by_id", "schema_permissions.tf_acc_role_ds.tables.dogs.read", "true"),
+					resource.TestCheckResourceAttr("data.harperdb_role.super_user", "super_user", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_role" "missing" {
+  name = "tf_acc_role_ds_missing"
+}
+`, testAccProviderTF()),
+				ExpectError: regexp.MustCompile("Role Not Found"),
+			},
+		},
+	})
+}
+
+func TestRoleDataSourceSchema(t *testing.T) {
+	resp := &datasource.SchemaResponse{}
+	NewRoleDataSource().Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	got := resp.Schema.Type().TerraformType(context.Background())
+	want := types.ObjectType{AttrTypes: roleDataSourceAttrTypes}.TerraformType(context.Background())
+	if !got.Equal(want) {
+		t.Errorf("schema type %s does not match roleDataSourceAttrTypes %s", got, want)
+	}
+}
+
+func TestFlattenRole(t *testing.T) {
+	ctx := context.Background()
+	role := harperdb.Role{
+		ID:   "1",
+		Role: "dev",
+		Permission: harperdb.Permission{
+			"super_user":     false,
+			"structure_user": []interface{}{"dogs"},
+			"dogs": map[string]interface{}{
+				"tables": map[string]interface{}{
+					"breeds": map[string]interface{}{
+						"read":                  true,
+						"insert":                false,
+						"update":                false,
+						"delete":                false,
+						"attribute_permissions": []interface{}{},
+					},
+				},
+			},
+		},
+	}
+
+	data, diags := flattenRole(ctx, role)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if data.SuperUser.ValueBool() || data.StructureUser.ValueBool() {
+		t.Errorf("expected no super_user and structure_user flags, got %+v", data)
+	}
+
+	if got := len(data.StructureUserSchemas.Elements()); got != 1 {
+		t.Errorf("expected one structure_user schema, got %d", got)
+	}
+
+	if _, ok := data.SchemaPermissions.Elements()["dogs"]; !ok {
+		t.Errorf("expected schema_permissions for dogs, got %s", data.SchemaPermissions)
+	}
+
+	want := `{"dogs":{"tables":{"breeds":{"read":true,"insert":false,"update":false,"delete":false,"attribute_permissions":[]}}},"structure_user":["dogs"]}`
+	if got := data.PermissionJSON.ValueString(); got != want {
+		t.Errorf("expected permission_json %s, got %s", want, got)
+	}
+}
+
+// TestFindRoleByName ensures that a role named like the ID of another role
+// resolves to the named role.
+func TestFindRoleByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id": "ops", "role": "dev"}, {"id": "2", "role": "ops"}]`))
+	}))
+	defer server.Close()
+
+	client := harperdb.NewClient(server.URL, "user", "password")
+
+	testCases := map[string]struct {
+		find   func() (*harperdb.Role, error)
+		wantID string
+	}{
+		"name": {
+			find:   func() (*harperdb.Role, error) { return findRoleByName(client, "ops") },
+			wantID: "2",
+		},
+		"id": {
+			find:   func() (*harperdb.Role, error) { return findRole(client, "ops", false) },
+			wantID: "ops",
+		},
+		"missing-name": {
+			find: func() (*harperdb.Role, error) { return findRoleByName(client, "2") },
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			role, err := tc.find()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotID string
+			if role != nil {
+				gotID = role.ID
+			}
+			if gotID != tc.wantID {
+				t.Errorf("expected role %q, got %q", tc.wantID, gotID)
+			}
+		})
+	}
+}
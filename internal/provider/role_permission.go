@@ -0,0 +1,648 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Keys of a HarperDB permission document which are role flags rather than
+// schema names.
+const (
+	permissionKeySuperUser     = "super_user"
+	permissionKeyClusterUser   = "cluster_user"
+	permissionKeyStructureUser = "structure_user"
+)
+
+// Values of attribute_mode.
+const (
+	attributeModeInherit       = "inherit"
+	attributeModeDenyByDefault = "deny_by_default"
+)
+
+// RoleSchemaPermissionModel describes a single entry of schema_permissions.
+type RoleSchemaPermissionModel struct {
+	Tables                  types.Map    `tfsdk:"tables"`
+	DefaultTablePermissions types.Object `tfsdk:"default_table_permissions"`
+	ExpandedTables          types.Set    `tfsdk:"expanded_tables"`
+}
+
+// RoleDefaultTablePermissionModel describes default_table_permissions.
+type RoleDefaultTablePermissionModel struct {
+	Read   types.Bool `tfsdk:"read"`
+	Insert types.Bool `tfsdk:"insert"`
+	Update types.Bool `tfsdk:"update"`
+	Delete types.Bool `tfsdk:"delete"`
+}
+
+// RoleTablePermissionModel describes a single entry of tables.
+type RoleTablePermissionModel struct {
+	Read                 types.Bool   `tfsdk:"read"`
+	Insert               types.Bool   `tfsdk:"insert"`
+	Update               types.Bool   `tfsdk:"update"`
+	Delete               types.Bool   `tfsdk:"delete"`
+	AttributePermissions types.Set    `tfsdk:"attribute_permissions"`
+	AttributeMode        types.String `tfsdk:"attribute_mode"`
+	DeniedAttributes     types.Set    `tfsdk:"denied_attributes"`
+}
+
+// RoleAttributePermissionModel describes a single entry of attribute_permissions.
+type RoleAttributePermissionModel struct {
+	Name   types.String `tfsdk:"name"`
+	Read   types.Bool   `tfsdk:"read"`
+	Insert types.Bool   `tfsdk:"insert"`
+	Update types.Bool   `tfsdk:"update"`
+}
+
+var roleAttributePermissionAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"read":   types.BoolType,
+	"insert": types.BoolType,
+	"update": types.BoolType,
+}
+
+var roleTablePermissionAttrTypes = map[string]attr.Type{
+	"read":                  types.BoolType,
+	"insert":                types.BoolType,
+	"update":                types.BoolType,
+	"delete":                types.BoolType,
+	"attribute_permissions": attributePermissionsType,
+	"attribute_mode":        types.StringType,
+	"denied_attributes":     types.SetType{ElemType: types.StringType},
+}
+
+var roleDefaultTablePermissionAttrTypes = map[string]attr.Type{
+	"read":   types.BoolType,
+	"insert": types.BoolType,
+	"update": types.BoolType,
+	"delete": types.BoolType,
+}
+
+var roleSchemaPermissionAttrTypes = map[string]attr.Type{
+	"tables": types.MapType{
+		ElemType: types.ObjectType{AttrTypes: roleTablePermissionAttrTypes},
+	},
+	"default_table_permissions": types.ObjectType{AttrTypes: roleDefaultTablePermissionAttrTypes},
+	"expanded_tables":           types.SetType{ElemType: types.StringType},
+}
+
+// isSystemAttribute reports whether a table attribute is maintained by
+// HarperDB itself. HarperDB rejects permissions on these attributes.
+func isSystemAttribute(name string) bool {
+	return name == "__createdtime__" || name == "__updatedtime__"
+}
+
+// isPermissionFlag reports whether key of a permission document is a role
+// flag rather than a schema name.
+func isPermissionFlag(key string) bool {
+	return key == permissionKeySuperUser || key == permissionKeyClusterUser || key == permissionKeyStructureUser
+}
+
+// structureUser decodes the structure_user flag, which is either a boolean
+// granting structure operations on every schema or a list of schema names.
+func structureUser(value interface{}) (bool, []string, error) {
+	switch v := value.(type) {
+	case nil:
+		return false, nil, nil
+	case bool:
+		return v, nil, nil
+	case []string:
+		return false, v, nil
+	case []interface{}:
+		schemas := make([]string, 0, len(v))
+		for _, raw := range v {
+			name, ok := raw.(string)
+			if !ok {
+				return false, nil, fmt.Errorf("%q must be a boolean or a list of schema names", permissionKeyStructureUser)
+			}
+			schemas = append(schemas, name)
+		}
+		return false, schemas, nil
+	default:
+		return false, nil, fmt.Errorf("%q must be a boolean or a list of schema names", permissionKeyStructureUser)
+	}
+}
+
+// setStructureUser stores the structure_user flag in perm. Nothing is written
+// when no structure permission is granted, as HarperDB releases predating the
+// flag treat every unknown key as a schema name.
+func setStructureUser(perm harperdb.Permission, all bool, schemas []string) {
+	switch {
+	case all:
+		perm[permissionKeyStructureUser] = true
+	case len(schemas) > 0:
+		perm[permissionKeyStructureUser] = schemas
+	default:
+		delete(perm, permissionKeyStructureUser)
+	}
+}
+
+// permissionFlag returns the value of a boolean role flag, treating anything
+// other than a JSON true as false.
+func permissionFlag(perm harperdb.Permission, key string) bool {
+	flag, ok := perm[key].(bool)
+	return ok && flag
+}
+
+// schemaPermissions extracts the per-schema entries of a permission document
+// as returned by list_roles, skipping the role flags.
+func schemaPermissions(perm harperdb.Permission) (map[string]harperdb.SchemaPermission, error) {
+	schemas := map[string]harperdb.SchemaPermission{}
+	for key, raw := range perm {
+		if isPermissionFlag(key) {
+			continue
+		}
+
+		// list_roles returns generic JSON, round-trip it into the typed SDK structure.
+		b, err := json.Marshal(raw)
+		if err != nil {
+			return nil, fmt.Errorf("schema %q: %w", key, err)
+		}
+		var sp harperdb.SchemaPermission
+		if err := json.Unmarshal(b, &sp); err != nil {
+			return nil, fmt.Errorf("schema %q: %w", key, err)
+		}
+		schemas[key] = sp
+	}
+
+	return schemas, nil
+}
+
+// typedRolePermission converts a permission document as returned by
+// list_roles into one holding harperdb.SchemaPermission values, suitable for
+// modification and alter_role. Role flags are kept as returned.
+func typedRolePermission(perm harperdb.Permission) (harperdb.Permission, error) {
+	schemas, err := schemaPermissions(perm)
+	if err != nil {
+		return nil, err
+	}
+
+	typed := harperdb.Permission{}
+	for key, value := range perm {
+		if isPermissionFlag(key) {
+			typed[key] = value
+		}
+	}
+	for name, sp := range schemas {
+		typed.AddSchemaPermission(name, sp)
+	}
+
+	return typed, nil
+}
+
+// normalizeRolePermission renders a permission document as returned by
+// list_roles in the canonical form used by permission_json.
+func normalizeRolePermission(perm harperdb.Permission) (string, error) {
+	schemas, err := schemaPermissions(perm)
+	if err != nil {
+		return "", err
+	}
+
+	all, structureSchemas, err := structureUser(perm[permissionKeyStructureUser])
+	if err != nil {
+		return "", err
+	}
+
+	typed := harperdb.Permission{}
+	typed.SetSuperUser(permissionFlag(perm, permissionKeySuperUser))
+	typed.SetClusterUser(permissionFlag(perm, permissionKeyClusterUser))
+	setStructureUser(typed, all, structureSchemas)
+	for name, sp := range schemas {
+		typed.AddSchemaPermission(name, sp)
+	}
+
+	return normalizePermission(typed)
+}
+
+// flattenSchemaPermissions converts the schema entries of a permission document
+// into the schema_permissions attribute value. Empty collections are returned
+// as null so that they match an omitted attribute in the configuration.
+// For schemas with default_table_permissions in prior, tables which are not
+// listed explicitly and still hold the default grant are reported as
+// expanded_tables rather than as tables.
+func flattenSchemaPermissions(ctx context.Context, schemas map[string]harperdb.SchemaPermission, prior types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}
+
+	priorModels := map[string]RoleSchemaPermissionModel{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorModels, false)...)
+	}
+
+	// Schemas granted nothing but defaults may be missing on the server while
+	// none of their tables exist yet.
+	all := make(map[string]harperdb.SchemaPermission, len(schemas))
+	for name, sp := range schemas {
+		all[name] = sp
+	}
+	for name, model := range priorModels {
+		if _, ok := all[name]; !ok && !model.DefaultTablePermissions.IsNull() {
+			all[name] = harperdb.SchemaPermission{}
+		}
+	}
+
+	if len(all) == 0 || diags.HasError() {
+		return types.MapNull(elemType), diags
+	}
+
+	models := map[string]RoleSchemaPermissionModel{}
+	for name, sp := range all {
+		model := RoleSchemaPermissionModel{
+			DefaultTablePermissions: types.ObjectNull(roleDefaultTablePermissionAttrTypes),
+			ExpandedTables:          types.SetNull(types.StringType),
+		}
+
+		tables := sp.Tables
+		priorTables := types.MapNull(types.ObjectType{AttrTypes: roleTablePermissionAttrTypes})
+		priorModel, ok := priorModels[name]
+		if ok {
+			priorTables = priorModel.Tables
+		}
+
+		if ok && !priorModel.DefaultTablePermissions.IsNull() {
+			var expanded []string
+			var d diag.Diagnostics
+			tables, expanded, d = splitDefaultTables(ctx, sp.Tables, priorModel)
+			diags.Append(d...)
+
+			model.DefaultTablePermissions = priorModel.DefaultTablePermissions
+			model.ExpandedTables, d = types.SetValueFrom(ctx, types.StringType, expanded)
+			diags.Append(d...)
+		}
+
+		var d diag.Diagnostics
+		model.Tables, d = flattenTablePermissions(ctx, tables, priorTables)
+		diags.Append(d...)
+		models[name] = model
+	}
+
+	if diags.HasError() {
+		return types.MapNull(elemType), diags
+	}
+
+	value, d := types.MapValueFrom(ctx, elemType, models)
+	diags.Append(d...)
+
+	return value, diags
+}
+
+// splitDefaultTables separates the tables granted through the
+// default_table_permissions of prior from the explicitly listed ones. A table
+// whose grant was changed outside of Terraform is kept with the explicit
+// tables, so that the next plan restores the default.
+func splitDefaultTables(ctx context.Context, tables map[string]harperdb.TablePermission, prior RoleSchemaPermissionModel) (map[string]harperdb.TablePermission, []string, diag.Diagnostics) {
+	defaults, _, diags := defaultTablePermission(ctx, prior.DefaultTablePermissions)
+	if diags.HasError() {
+		return tables, nil, diags
+	}
+
+	explicit := prior.Tables.Elements()
+	remaining := map[string]harperdb.TablePermission{}
+	expanded := []string{}
+	for name, tp := range tables {
+		if _, ok := explicit[name]; !ok && isDefaultTablePermission(tp, defaults) {
+			expanded = append(expanded, name)
+			continue
+		}
+		remaining[name] = tp
+	}
+
+	return remaining, expanded, diags
+}
+
+// defaultTablePermission converts a default_table_permissions value into the
+// grant applied to each expanded table. The returned flag is false while any
+// of the operations is unknown.
+func defaultTablePermission(ctx context.Context, value types.Object) (harperdb.TablePermission, bool, diag.Diagnostics) {
+	var model RoleDefaultTablePermissionModel
+	diags := value.As(ctx, &model, basetypes.ObjectAsOptions{})
+
+	return harperdb.TablePermission{
+		Read:                 model.Read.ValueBool(),
+		Insert:               model.Insert.ValueBool(),
+		Update:               model.Update.ValueBool(),
+		Delete:               model.Delete.ValueBool(),
+		AttributePermissions: []harperdb.AttributePermissions{},
+	}, allKnown(model.Read, model.Insert, model.Update, model.Delete), diags
+}
+
+func isDefaultTablePermission(tp, defaults harperdb.TablePermission) bool {
+	return tp.Read == defaults.Read &&
+		tp.Insert == defaults.Insert &&
+		tp.Update == defaults.Update &&
+		tp.Delete == defaults.Delete &&
+		len(tp.AttributePermissions) == 0
+}
+
+// flattenTablePermissions converts the tables of a schema permission into the
+// tables attribute value. For tables with attribute_mode deny_by_default in
+// prior, attributes which are not listed explicitly and are denied entirely
+// are reported as denied_attributes rather than as attribute_permissions.
+func flattenTablePermissions(ctx context.Context, tables map[string]harperdb.TablePermission, prior types.Map) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: roleTablePermissionAttrTypes}
+
+	if len(tables) == 0 {
+		return types.MapNull(elemType), diags
+	}
+
+	priorModels := map[string]RoleTablePermissionModel{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorModels, false)...)
+	}
+
+	models := map[string]RoleTablePermissionModel{}
+	for name, tp := range tables {
+		model := RoleTablePermissionModel{
+			Read:             types.BoolValue(tp.Read),
+			Insert:           types.BoolValue(tp.Insert),
+			Update:           types.BoolValue(tp.Update),
+			Delete:           types.BoolValue(tp.Delete),
+			AttributeMode:    types.StringValue(attributeModeInherit),
+			DeniedAttributes: types.SetNull(types.StringType),
+		}
+
+		attributes := tp.AttributePermissions
+		if priorModel, ok := priorModels[name]; ok && priorModel.AttributeMode.ValueString() == attributeModeDenyByDefault {
+			var denied []string
+			var d diag.Diagnostics
+			attributes, denied, d = splitDeniedAttributes(ctx, tp.AttributePermissions, priorModel.AttributePermissions)
+			diags.Append(d...)
+
+			model.AttributeMode = priorModel.AttributeMode
+			model.DeniedAttributes, d = types.SetValueFrom(ctx, types.StringType, denied)
+			diags.Append(d...)
+		}
+
+		var d diag.Diagnostics
+		model.AttributePermissions, d = flattenAttributePermissions(ctx, attributes)
+		diags.Append(d...)
+		models[name] = model
+	}
+
+	if diags.HasError() {
+		return types.MapNull(elemType), diags
+	}
+
+	value, d := types.MapValueFrom(ctx, elemType, models)
+	diags.Append(d...)
+
+	return value, diags
+}
+
+// splitDeniedAttributes separates the attributes denied by deny_by_default
+// from the ones listed in the prior attribute_permissions. An attribute
+// granted outside of Terraform is kept with the listed ones, so that the next
+// plan denies it again.
+func splitDeniedAttributes(ctx context.Context, attributes []harperdb.AttributePermissions, prior types.Set) ([]harperdb.AttributePermissions, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	listed := map[string]RoleAttributePermissionModel{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		listed, diags = attributePermissionsByName(ctx, prior)
+	}
+
+	remaining := []harperdb.AttributePermissions{}
+	denied := []string{}
+	for _, ap := range attributes {
+		_, ok := listed[ap.AttributeName]
+		if !ok && !ap.Read && !ap.Insert && !ap.Update {
+			denied = append(denied, ap.AttributeName)
+			continue
+		}
+		remaining = append(remaining, ap)
+	}
+
+	return remaining, denied, diags
+}
+
+func flattenAttributePermissions(ctx context.Context, attributes []harperdb.AttributePermissions) (types.Set, diag.Diagnostics) {
+	if len(attributes) == 0 {
+		return types.SetNull(attributePermissionsType.ElemType), nil
+	}
+
+	// HarperDB stores a list, collapse repeated names into a single entry.
+	byName := map[string]int{}
+	models := make([]RoleAttributePermissionModel, 0, len(attributes))
+	for _, ap := range attributes {
+		model := RoleAttributePermissionModel{
+			Name:   types.StringValue(ap.AttributeName),
+			Read:   types.BoolValue(ap.Read),
+			Insert: types.BoolValue(ap.Insert),
+			Update: types.BoolValue(ap.Update),
+		}
+
+		if i, ok := byName[ap.AttributeName]; ok {
+			models[i] = model
+			continue
+		}
+		byName[ap.AttributeName] = len(models)
+		models = append(models, model)
+	}
+
+	value, diags := types.SetValueFrom(ctx, attributePermissionsType.ElemType, models)
+	if diags.HasError() {
+		return types.SetNull(attributePermissionsType.ElemType), diags
+	}
+
+	return value, diags
+}
+
+// pathDiagnostics attaches p to every diagnostic which does not carry a path
+// yet, so that conversion errors point at the offending attribute.
+func pathDiagnostics(p path.Path, diags diag.Diagnostics) diag.Diagnostics {
+	result := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		if _, ok := d.(diag.DiagnosticWithPath); ok {
+			result = append(result, d)
+			continue
+		}
+		result = append(result, diag.WithPath(p, d))
+	}
+
+	return result
+}
+
+// expandSchemaPermissions converts the schema_permissions attribute value at p
+// into the SDK structure. The returned flag is false when any part of the value
+// is unknown, in which case the result is incomplete and must not be sent to
+// the server.
+func expandSchemaPermissions(ctx context.Context, p path.Path, value types.Map) (map[string]harperdb.SchemaPermission, bool, diag.Diagnostics) {
+	if value.IsNull() {
+		return nil, true, nil
+	}
+	if value.IsUnknown() {
+		return nil, false, nil
+	}
+
+	var models map[string]RoleSchemaPermissionModel
+	if diags := value.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil, false, pathDiagnostics(p, diags)
+	}
+
+	var diags diag.Diagnostics
+	known := true
+	schemas := make(map[string]harperdb.SchemaPermission, len(models))
+	for name, model := range models {
+		tables, ok, d := expandTablePermissions(ctx, p.AtMapKey(name).AtName("tables"), model.Tables)
+		diags.Append(d...)
+		known = known && ok
+
+		defaults, expanded, ok, d := expandDefaultTables(ctx, p.AtMapKey(name), model)
+		diags.Append(d...)
+		known = known && ok
+
+		// Explicitly listed tables take precedence over the defaults.
+		for _, table := range expanded {
+			if _, ok := tables[table]; !ok {
+				tables[table] = defaults
+			}
+		}
+
+		schemas[name] = harperdb.SchemaPermission{Tables: tables}
+	}
+
+	return schemas, known, diags
+}
+
+// expandDefaultTables returns the default_table_permissions grant of a schema
+// entry at p along with the tables it applies to. expanded_tables is null in
+// configurations, only the grant itself is checked then.
+func expandDefaultTables(ctx context.Context, p path.Path, model RoleSchemaPermissionModel) (harperdb.TablePermission, []string, bool, diag.Diagnostics) {
+	if model.DefaultTablePermissions.IsNull() {
+		return harperdb.TablePermission{}, nil, true, nil
+	}
+	if model.DefaultTablePermissions.IsUnknown() || model.ExpandedTables.IsUnknown() {
+		return harperdb.TablePermission{}, nil, false, nil
+	}
+
+	grant, known, diags := defaultTablePermission(ctx, model.DefaultTablePermissions)
+	if diags.HasError() {
+		return grant, nil, false, pathDiagnostics(p.AtName("default_table_permissions"), diags)
+	}
+
+	var expanded []string
+	if d := model.ExpandedTables.ElementsAs(ctx, &expanded, false); d.HasError() {
+		return grant, nil, false, pathDiagnostics(p.AtName("expanded_tables"), d)
+	}
+
+	return grant, expanded, known, nil
+}
+
+func expandTablePermissions(ctx context.Context, p path.Path, value types.Map) (map[string]harperdb.TablePermission, bool, diag.Diagnostics) {
+	// HarperDB expects an object even when no table is granted.
+	tables := map[string]harperdb.TablePermission{}
+
+	if value.IsNull() {
+		return tables, true, nil
+	}
+	if value.IsUnknown() {
+		return tables, false, nil
+	}
+
+	var models map[string]RoleTablePermissionModel
+	if diags := value.ElementsAs(ctx, &models, false); diags.HasError() {
+		return tables, false, pathDiagnostics(p, diags)
+	}
+
+	var diags diag.Diagnostics
+	known := true
+	for name, model := range models {
+		attributes, ok, d := expandAttributePermissions(ctx, p.AtMapKey(name).AtName("attribute_permissions"), model.AttributePermissions)
+		diags.Append(d...)
+		known = known && ok && allKnown(model.Read, model.Insert, model.Update, model.Delete, model.AttributeMode, model.DeniedAttributes)
+
+		// denied_attributes is null in configurations, only the listed
+		// attributes are checked then.
+		if model.AttributeMode.ValueString() == attributeModeDenyByDefault && !model.DeniedAttributes.IsUnknown() {
+			var denied []string
+			diags.Append(pathDiagnostics(p.AtMapKey(name).AtName("denied_attributes"), model.DeniedAttributes.ElementsAs(ctx, &denied, false))...)
+			attributes = denyAttributes(attributes, denied)
+		}
+
+		tables[name] = harperdb.TablePermission{
+			Read:                 model.Read.ValueBool(),
+			Insert:               model.Insert.ValueBool(),
+			Update:               model.Update.ValueBool(),
+			Delete:               model.Delete.ValueBool(),
+			AttributePermissions: attributes,
+		}
+	}
+
+	return tables, known, diags
+}
+
+// denyAttributes appends an entry denying every operation for each of the
+// denied attributes which is not listed in attributes yet.
+func denyAttributes(attributes []harperdb.AttributePermissions, denied []string) []harperdb.AttributePermissions {
+	listed := make(map[string]bool, len(attributes))
+	for _, ap := range attributes {
+		listed[ap.AttributeName] = true
+	}
+
+	for _, name := range denied {
+		if !listed[name] {
+			attributes = append(attributes, harperdb.AttributePermissions{AttributeName: name})
+		}
+	}
+
+	return attributes
+}
+
+func expandAttributePermissions(ctx context.Context, p path.Path, value types.Set) ([]harperdb.AttributePermissions, bool, diag.Diagnostics) {
+	attributes := []harperdb.AttributePermissions{}
+
+	if value.IsNull() {
+		return attributes, true, nil
+	}
+	if value.IsUnknown() {
+		return attributes, false, nil
+	}
+
+	var models []RoleAttributePermissionModel
+	if diags := value.ElementsAs(ctx, &models, false); diags.HasError() {
+		return attributes, false, pathDiagnostics(p, diags)
+	}
+
+	known := true
+	for _, model := range models {
+		known = known && allKnown(model.Name, model.Read, model.Insert, model.Update)
+		attributes = append(attributes, harperdb.AttributePermissions{
+			AttributeName: model.Name.ValueString(),
+			Read:          model.Read.ValueBool(),
+			Insert:        model.Insert.ValueBool(),
+			Update:        model.Update.ValueBool(),
+		})
+	}
+
+	return attributes, known, nil
+}
+
+// allKnown reports whether none of the values are unknown.
+func allKnown(values ...attr.Value) bool {
+	for _, v := range values {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// contains reports whether values holds value.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testTablePermissionValue(t *testing.T, read attr.Value, attributes attr.Value) attr.Value {
+	t.Helper()
+
+	v, diags := types.ObjectValue(roleTablePermissionAttrTypes, map[string]attr.Value{
+		"read":                  read,
+		"insert":                types.BoolValue(false),
+		"update":                types.BoolValue(false),
+		"delete":                types.BoolValue(true),
+		"attribute_permissions": attributes,
+		"attribute_mode":        types.StringValue(attributeModeInherit),
+		"denied_attributes":     types.SetNull(types.StringType),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	return v
+}
+
+func testDefaultTablePermissionValue(t *testing.T, read attr.Value) attr.Value {
+	t.Helper()
+
+	v, diags := types.ObjectValue(roleDefaultTablePermissionAttrTypes, map[string]attr.Value{
+		"read":   read,
+		"insert": types.BoolValue(false),
+		"update": types.BoolValue(false),
+		"delete": types.BoolValue(false),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	return v
+}
+
+func testSchemaPermissionsValue(t *testing.T, tables attr.Value) types.Map {
+	t.Helper()
+
+	return testSchemaPermissionsDefaultsValue(t, tables, types.ObjectNull(roleDefaultTablePermissionAttrTypes), types.SetNull(types.StringType))
+}
+
+func testSchemaPermissionsDefaultsValue(t *testing.T, tables attr.Value, defaults attr.Value, expanded attr.Value) types.Map {
+	t.Helper()
+
+	schema, diags := types.ObjectValue(roleSchemaPermissionAttrTypes, map[string]attr.Value{
+		"tables":                    tables,
+		"default_table_permissions": defaults,
+		"expanded_tables":           expanded,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	v, diags := types.MapValue(types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}, map[string]attr.Value{
+		"dogs": schema,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	return v
+}
+
+func TestExpandSchemaPermissions(t *testing.T) {
+	ctx := context.Background()
+	tableType := types.ObjectType{AttrTypes: roleTablePermissionAttrTypes}
+
+	knownTables := types.MapValueMust(tableType, map[string]attr.Value{
+		"breeds": testTablePermissionValue(t, types.BoolValue(true), types.SetNull(attributePermissionsType.ElemType)),
+	})
+	unknownReadTables := types.MapValueMust(tableType, map[string]attr.Value{
+		"breeds": testTablePermissionValue(t, types.BoolUnknown(), types.SetNull(attributePermissionsType.ElemType)),
+	})
+
+	testCases := map[string]struct {
+		value     types.Map
+		wantKnown bool
+		wantRead  bool
+	}{
+		"null": {
+			value:     types.MapNull(types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}),
+			wantKnown: true,
+		},
+		"unknown": {
+			value: types.MapUnknown(types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}),
+		},
+		"null-tables": {
+			value:     testSchemaPermissionsValue(t, types.MapNull(tableType)),
+			wantKnown: true,
+		},
+		"unknown-tables": {
+			value: testSchemaPermissionsValue(t, types.MapUnknown(tableType)),
+		},
+		"unknown-flag": {
+			value: testSchemaPermissionsValue(t, unknownReadTables),
+		},
+		"known": {
+			value:     testSchemaPermissionsValue(t, knownTables),
+			wantKnown: true,
+			wantRead:  true,
+		},
+		"defaults-config": {
+			value:     testSchemaPermissionsDefaultsValue(t, knownTables, testDefaultTablePermissionValue(t, types.BoolValue(true)), types.SetNull(types.StringType)),
+			wantKnown: true,
+			wantRead:  true,
+		},
+		"defaults-unknown-flag": {
+			value: testSchemaPermissionsDefaultsValue(t, knownTables, testDefaultTablePermissionValue(t, types.BoolUnknown()), types.SetNull(types.StringType)),
+		},
+		"defaults-unknown-expanded-tables": {
+			value: testSchemaPermissionsDefaultsValue(t, knownTables, testDefaultTablePermissionValue(t, types.BoolValue(true)), types.SetUnknown(types.StringType)),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			schemas, known, diags := expandSchemaPermissions(ctx, path.Root("schema_permissions"), tc.value)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if known != tc.wantKnown {
+				t.Errorf("expected known %t, got %t", tc.wantKnown, known)
+			}
+
+			if tc.wantRead && !schemas["dogs"].Tables["breeds"].Read {
+				t.Errorf("expected read permission on dogs.breeds, got %+v", schemas)
+			}
+		})
+	}
+}
+
+func TestExpandSchemaPermissions_defaultTables(t *testing.T) {
+	ctx := context.Background()
+	tables := types.MapValueMust(types.ObjectType{AttrTypes: roleTablePermissionAttrTypes}, map[string]attr.Value{
+		"breeds": testTablePermissionValue(t, types.BoolValue(false), types.SetNull(attributePermissionsType.ElemType)),
+	})
+	expanded := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("breeds"),
+		types.StringValue("owners"),
+	})
+	value := testSchemaPermissionsDefaultsValue(t, tables, testDefaultTablePermissionValue(t, types.BoolValue(true)), expanded)
+
+	schemas, known, diags := expandSchemaPermissions(ctx, path.Root("schema_permissions"), value)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !known {
+		t.Fatal("expected permissions to be known")
+	}
+
+	if breeds := schemas["dogs"].Tables["breeds"]; breeds.Read || !breeds.Delete {
+		t.Errorf("expected the explicit grant of dogs.breeds to take precedence, got %+v", breeds)
+	}
+
+	if owners := schemas["dogs"].Tables["owners"]; !owners.Read || owners.Delete {
+		t.Errorf("expected dogs.owners to be granted the defaults, got %+v", owners)
+	}
+}
+
+func TestFlattenSchemaPermissions_defaultTables(t *testing.T) {
+	ctx := context.Background()
+	tables := types.MapValueMust(types.ObjectType{AttrTypes: roleTablePermissionAttrTypes}, map[string]attr.Value{
+		"breeds": testTablePermissionValue(t, types.BoolValue(true), types.SetNull(attributePermissionsType.ElemType)),
+	})
+	prior := testSchemaPermissionsDefaultsValue(t, tables, testDefaultTablePermissionValue(t, types.BoolValue(true)), types.SetNull(types.StringType))
+
+	value, diags := flattenSchemaPermissions(ctx, map[string]harperdb.SchemaPermission{
+		"dogs": {Tables: map[string]harperdb.TablePermission{
+			"breeds": {Read: true, Delete: true},
+			"owners": {Read: true},
+			// Changed outside of Terraform, reported as an explicit table.
+			"walks": {Read: true, Insert: true},
+		}},
+	}, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var models map[string]RoleSchemaPermissionModel
+	if diags := value.ElementsAs(ctx, &models, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	dogs := models["dogs"]
+	if !dogs.DefaultTablePermissions.Equal(testDefaultTablePermissionValue(t, types.BoolValue(true))) {
+		t.Errorf("expected default_table_permissions to be preserved, got %s", dogs.DefaultTablePermissions)
+	}
+
+	wantExpanded := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("owners")})
+	if !dogs.ExpandedTables.Equal(wantExpanded) {
+		t.Errorf("expected expanded_tables %s, got %s", wantExpanded, dogs.ExpandedTables)
+	}
+
+	if _, ok := dogs.Tables.Elements()["owners"]; ok {
+		t.Errorf("expected dogs.owners to be omitted from tables, got %s", dogs.Tables)
+	}
+
+	for _, name := range []string{"breeds", "walks"} {
+		if _, ok := dogs.Tables.Elements()[name]; !ok {
+			t.Errorf("expected dogs.%s in tables, got %s", name, dogs.Tables)
+		}
+	}
+}
+
+func testDenyByDefaultTablesValue(t *testing.T, attributes attr.Value, denied attr.Value) types.Map {
+	t.Helper()
+
+	table, diags := types.ObjectValue(roleTablePermissionAttrTypes, map[string]attr.Value{
+		"read":                  types.BoolValue(true),
+		"insert":                types.BoolValue(false),
+		"update":                types.BoolValue(false),
+		"delete":                types.BoolValue(false),
+		"attribute_permissions": attributes,
+		"attribute_mode":        types.StringValue(attributeModeDenyByDefault),
+		"denied_attributes":     denied,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	return types.MapValueMust(types.ObjectType{AttrTypes: roleTablePermissionAttrTypes}, map[string]attr.Value{
+		"breeds": table,
+	})
+}
+
+func TestExpandTablePermissions_denyByDefault(t *testing.T) {
+	ctx := context.Background()
+	denied := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("name"),
+		types.StringValue("owner"),
+	})
+
+	testCases := map[string]struct {
+		value     types.Map
+		wantKnown bool
+		want      map[string]bool
+	}{
+		"config": {
+			value:     testDenyByDefaultTablesValue(t, testAttributePermissionsValue(t, testAttributePermission("name", true, false, false)), types.SetNull(types.StringType)),
+			wantKnown: true,
+			want:      map[string]bool{"name": true},
+		},
+		"unknown-denied-attributes": {
+			value: testDenyByDefaultTablesValue(t, testAttributePermissionsValue(t, testAttributePermission("name", true, false, false)), types.SetUnknown(types.StringType)),
+			want:  map[string]bool{"name": true},
+		},
+		"denied-attributes": {
+			value:     testDenyByDefaultTablesValue(t, testAttributePermissionsValue(t, testAttributePermission("name", true, false, false)), denied),
+			wantKnown: true,
+			want:      map[string]bool{"name": true, "owner": false},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tables, known, diags := expandTablePermissions(ctx, path.Root("tables"), tc.value)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if known != tc.wantKnown {
+				t.Errorf("expected known %t, got %t", tc.wantKnown, known)
+			}
+
+			got := map[string]bool{}
+			for _, ap := range tables["breeds"].AttributePermissions {
+				got[ap.AttributeName] = ap.Read
+			}
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected attribute permissions %v, got %v", tc.want, got)
+			}
+			for attribute, read := range tc.want {
+				if r, ok := got[attribute]; !ok || r != read {
+					t.Errorf("expected attribute permissions %v, got %v", tc.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenTablePermissions_denyByDefault(t *testing.T) {
+	ctx := context.Background()
+	prior := testDenyByDefaultTablesValue(t, testAttributePermissionsValue(t, testAttributePermission("name", true, false, false)), types.SetNull(types.StringType))
+
+	value, diags := flattenTablePermissions(ctx, map[string]harperdb.TablePermission{
+		"breeds": {Read: true, AttributePermissions: []harperdb.AttributePermissions{
+			{AttributeName: "name", Read: true},
+			{AttributeName: "owner"},
+			// Granted outside of Terraform, reported as a listed attribute.
+			{AttributeName: "size", Read: true},
+		}},
+	}, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var models map[string]RoleTablePermissionModel
+	if diags := value.ElementsAs(ctx, &models, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	breeds := models["breeds"]
+	if got := breeds.AttributeMode.ValueString(); got != attributeModeDenyByDefault {
+		t.Errorf("expected attribute_mode %q, got %q", attributeModeDenyByDefault, got)
+	}
+
+	wantDenied := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("owner")})
+	if !breeds.DeniedAttributes.Equal(wantDenied) {
+		t.Errorf("expected denied_attributes %s, got %s", wantDenied, breeds.DeniedAttributes)
+	}
+
+	listed, diags := attributePermissionsByName(ctx, breeds.AttributePermissions)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if _, ok := listed["owner"]; ok || len(listed) != 2 {
+		t.Errorf("expected attribute_permissions for name and size only, got %v", listed)
+	}
+}
+
+func TestFlattenAttributePermissions(t *testing.T) {
+	value, diags := flattenAttributePermissions(context.Background(), []harperdb.AttributePermissions{
+		{AttributeName: "a", Read: true},
+		{AttributeName: "b"},
+		{AttributeName: "a", Read: true},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := len(value.Elements()); got != 2 {
+		t.Errorf("expected repeated attribute names to be collapsed into 2 entries, got %d", got)
+	}
+}
@@ -2,20 +2,36 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// roleImportedKey marks imported roles in the private state until their first
+// Read.
+const roleImportedKey = "imported"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RoleResource{}
 var _ resource.ResourceWithImportState = &RoleResource{}
+var _ resource.ResourceWithValidateConfig = &RoleResource{}
+var _ resource.ResourceWithConfigValidators = &RoleResource{}
+var _ resource.ResourceWithModifyPlan = &RoleResource{}
 
 func NewRoleResource() resource.Resource {
 	return &RoleResource{}
@@ -28,11 +44,15 @@ type RoleResource struct {
 
 // RoleResourceModel describes the resource data model.
 type RoleResourceModel struct {
-	ID                types.String `tfsdk:"id"`   // Derived from the resource-name
-	Name              types.String `tfsdk:"name"` // Role name
-	SuperUser         types.Bool   `tfsdk:"super_user"`
-	ClusterUser       types.Bool   `tfsdk:"cluster_user"`
-	SchemaPermissions types.Map    `tfsdk:"schema_permissions"`
+	ID                   types.String        `tfsdk:"id"`   // Derived from the resource-name
+	Name                 types.String        `tfsdk:"name"` // Role name
+	SuperUser            types.Bool          `tfsdk:"super_user"`
+	ClusterUser          types.Bool          `tfsdk:"cluster_user"`
+	StructureUser        types.Bool          `tfsdk:"structure_user"`
+	StructureUserSchemas types.Set           `tfsdk:"structure_user_schemas"`
+	SchemaPermissions    types.Map           `tfsdk:"schema_permissions"`
+	PermissionJSON       PermissionJSONValue `tfsdk:"permission_json"`
+	OnDestroyReassignTo  types.String        `tfsdk:"on_destroy_reassign_to"`
 	// TablePermissions  types.Map    `tfsdk:"table_permissions"`
 }
 
@@ -65,31 +85,23 @@ func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					Computed: true,
 					Default:  booldefault.StaticBool(false),
 				},
-				"attribute_permissions": schema.ListNestedAttribute{
+				"attribute_mode": schema.StringAttribute{
+					MarkdownDescription: "How attributes missing from `attribute_permissions` are treated: `inherit` (default) grants them the table permissions, " +
+						"`deny_by_default` denies them explicitly. Attributes are looked up with `describe_table` on every plan, even without changes, " +
+						"so that attributes added later show up in the plan. This costs one request per table in this mode.",
 					Optional: true,
-					NestedObject: schema.NestedAttributeObject{
-						Attributes: map[string]schema.Attribute{
-							"name": schema.StringAttribute{
-								Required: true,
-							},
-							"read": schema.BoolAttribute{
-								Optional: true,
-								Computed: true,
-								Default:  booldefault.StaticBool(false),
-							},
-							"insert": schema.BoolAttribute{
-								Optional: true,
-								Computed: true,
-								Default:  booldefault.StaticBool(false),
-							},
-							"update": schema.BoolAttribute{
-								Optional: true,
-								Computed: true,
-								Default:  booldefault.StaticBool(false),
-							},
-						},
+					Computed: true,
+					Default:  stringdefault.StaticString(attributeModeInherit),
+					Validators: []validator.String{
+						stringvalidator.OneOf(attributeModeInherit, attributeModeDenyByDefault),
 					},
 				},
+				"denied_attributes": schema.SetAttribute{
+					MarkdownDescription: "Attributes denied by `deny_by_default`",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"attribute_permissions": attributePermissionsSchema(),
 			},
 		},
 	}
@@ -115,14 +127,93 @@ func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "is cluster user",
 				Optional:            true,
 			},
+			"structure_user": schema.BoolAttribute{
+				MarkdownDescription: "Allows creating and dropping schemas and tables in every schema (HarperDB 4.x). " +
+					"Conflicts with `structure_user_schemas`.",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("structure_user_schemas")),
+				},
+			},
+			"structure_user_schemas": schema.SetAttribute{
+				MarkdownDescription: "Allows creating and dropping tables in the listed schemas only (HarperDB 4.x). " +
+					"Sent to HarperDB as the list form of `structure_user`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
 			"schema_permissions": schema.MapNestedAttribute{
+				MarkdownDescription: "Table grants keyed by schema. The role owns the tables listed here and every table of schemas with " +
+					"`default_table_permissions`. Grants on other tables, as added by `harperdb_role_table_permission`, are neither " +
+					"reported nor removed. An imported role, or one switching from `permission_json`, owns all of its tables.",
 				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"tables": tableSchema,
+						"default_table_permissions": schema.SingleNestedAttribute{
+							MarkdownDescription: "Grant applied to every table of the schema which is not listed in `tables`. " +
+								"Tables are looked up with `describe_schema` on every plan, even without changes, so that tables created later " +
+								"show up in the plan. This costs one request per schema with defaults.",
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"read": schema.BoolAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  booldefault.StaticBool(false),
+								},
+								"insert": schema.BoolAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  booldefault.StaticBool(false),
+								},
+								"update": schema.BoolAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  booldefault.StaticBool(false),
+								},
+								"delete": schema.BoolAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  booldefault.StaticBool(false),
+								},
+							},
+						},
+						"expanded_tables": schema.SetAttribute{
+							MarkdownDescription: "Tables granted by `default_table_permissions`",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
 					},
 				},
 			},
+			"on_destroy_reassign_to": schema.StringAttribute{
+				MarkdownDescription: "Name of the role the users still assigned to this role are moved to before it is destroyed. " +
+					"Without it, destroying a role which is still assigned fails and lists the users. " +
+					"Must be applied before the destroy to take effect.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"permission_json": schema.StringAttribute{
+				MarkdownDescription: "Native HarperDB permission document as JSON, for example exported from HarperDB Studio. " +
+					"Conflicts with `schema_permissions`, `super_user`, `cluster_user`, `structure_user` and `structure_user_schemas`.",
+				Optional:   true,
+				CustomType: PermissionJSONType{},
+				Validators: []validator.String{
+					permissionJSONValidator{},
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("schema_permissions"),
+						path.MatchRoot("super_user"),
+						path.MatchRoot("cluster_user"),
+						path.MatchRoot("structure_user"),
+						path.MatchRoot("structure_user_schemas"),
+					),
+				},
+			},
 		},
 	}
 }
@@ -147,47 +238,284 @@ func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
-func (r *RoleResource) constructPermission(data *RoleResourceModel) harperdb.Permission {
+// constructPermission builds the permission document sent to HarperDB from
+// the model. The returned flag is false while any part of the permissions is
+// still unknown.
+func (r *RoleResource) constructPermission(ctx context.Context, data *RoleResourceModel) (harperdb.Permission, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.PermissionJSON.IsUnknown() {
+		return nil, false, diags
+	}
+
+	if !data.PermissionJSON.IsNull() {
+		perm, err := parsePermissionJSON(data.PermissionJSON.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("permission_json"), "Invalid Permission Document", err.Error())
+			return nil, false, diags
+		}
+
+		return perm, true, diags
+	}
+
 	perm := harperdb.Permission{}
 	perm.SetClusterUser(data.ClusterUser.ValueBool())
 	perm.SetSuperUser(data.SuperUser.ValueBool())
 
-	schemas := data.SchemaPermissions.Elements()
+	schemas, known, d := expandSchemaPermissions(ctx, path.Root("schema_permissions"), data.SchemaPermissions)
+	diags.Append(d...)
 
-	for name, schema := range schemas {
-		tables := map[string]harperdb.TablePermission{}
+	if diags.HasError() {
+		return nil, false, diags
+	}
 
-		tablesRaw := schema.(types.Object).Attributes()["tables"].(types.Map).Elements()
-		for tname, traw := range tablesRaw {
-			attr := traw.(types.Object).Attributes()
-			var attributePermissions []harperdb.AttributePermissions
-			attributes := attr["attribute_permissions"].(types.List).Elements()
-			for _, attribute := range attributes {
-				a := attribute.(types.Object).Attributes()
-				attributePermissions = append(attributePermissions, harperdb.AttributePermissions{
-					AttributeName: a["name"].(types.String).ValueString(),
-					Read:          a["read"].(types.Bool).ValueBool(),
-					Insert:        a["insert"].(types.Bool).ValueBool(),
-					Update:        a["update"].(types.Bool).ValueBool(),
-				})
+	for name, schemaPermission := range schemas {
+		perm.AddSchemaPermission(name, schemaPermission)
+	}
 
+	var structureSchemas []string
+	if !data.StructureUserSchemas.IsUnknown() {
+		diags.Append(data.StructureUserSchemas.ElementsAs(ctx, &structureSchemas, false)...)
+	}
+	setStructureUser(perm, data.StructureUser.ValueBool(), structureSchemas)
+
+	known = known && allKnown(data.SuperUser, data.ClusterUser, data.StructureUser, data.StructureUserSchemas)
+
+	return perm, known, diags
+}
+
+func (r *RoleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		roleFlagsValidator{},
+		roleTableGrantsValidator{},
+	}
+}
+
+func (r *RoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RoleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemas, known, diags := expandSchemaPermissions(ctx, path.Root("schema_permissions"), data.SchemaPermissions)
+	resp.Diagnostics.Append(diags...)
+
+	// Table names commonly reference other resources, wait for them.
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
+
+	for schemaName, schemaPermission := range schemas {
+		schemaPath := path.Root("schema_permissions").AtMapKey(schemaName)
+		if schemaName == "" {
+			resp.Diagnostics.AddAttributeError(schemaPath, "Invalid Schema Name", "Schema names must not be empty.")
+		}
+
+		for tableName, tablePermission := range schemaPermission.Tables {
+			tablePath := schemaPath.AtName("tables").AtMapKey(tableName)
+			if tableName == "" {
+				resp.Diagnostics.AddAttributeError(tablePath, "Invalid Table Name", "Table names must not be empty.")
 			}
-			tables[tname] = harperdb.TablePermission{
-				Read:                 attr["read"].(types.Bool).ValueBool(),
-				Insert:               attr["insert"].(types.Bool).ValueBool(),
-				Update:               attr["update"].(types.Bool).ValueBool(),
-				Delete:               attr["delete"].(types.Bool).ValueBool(),
-				AttributePermissions: attributePermissions,
+
+			for _, attributePermission := range tablePermission.AttributePermissions {
+				if attributePermission.AttributeName == "" {
+					resp.Diagnostics.AddAttributeError(tablePath.AtName("attribute_permissions"), "Invalid Attribute Name", "Attribute names must not be empty.")
+				}
 			}
 		}
+	}
+}
+
+// ModifyPlan expands default_table_permissions and deny_by_default against the
+// tables and attributes currently present on the server, so that tables and
+// attributes created since the last apply show up as a change. This has to
+// happen on every plan, the lookups are limited to the schemas and tables
+// using these features.
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to expand on destroy or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var permissions types.Map
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schema_permissions"), &permissions)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := r.resolveSchemaPermissions(ctx, permissions, true)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("schema_permissions"), permissions)...)
+}
 
-		schemaPermission := harperdb.SchemaPermission{
-			Tables: tables,
+// resolveSchemaPermissions fills the values looked up from the server:
+// expanded_tables of every schema entry with default_table_permissions and
+// denied_attributes of every table entry in deny_by_default mode. While
+// planning the values are always looked up and left unknown for schemas and
+// tables which do not exist yet, at apply time only unknown values are
+// resolved.
+func (r *RoleResource) resolveSchemaPermissions(ctx context.Context, permissions types.Map, planning bool) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if permissions.IsNull() || permissions.IsUnknown() {
+		return permissions, diags
+	}
+
+	var models map[string]RoleSchemaPermissionModel
+	diags.Append(permissions.ElementsAs(ctx, &models, false)...)
+
+	if diags.HasError() {
+		return permissions, diags
+	}
+
+	for name, model := range models {
+		schemaPath := path.Root("schema_permissions").AtMapKey(name)
+
+		switch {
+		case model.DefaultTablePermissions.IsNull():
+			model.ExpandedTables = types.SetNull(types.StringType)
+		case model.Tables.IsUnknown():
+			model.ExpandedTables = types.SetUnknown(types.StringType)
+		case planning || model.ExpandedTables.IsUnknown():
+			description, err := r.client.DescribeSchema(name)
+			if err != nil {
+				if planning && isDoesNotExistError(err) {
+					// The schema is created within the same apply.
+					model.ExpandedTables = types.SetUnknown(types.StringType)
+					break
+				}
+
+				diags.AddAttributeError(schemaPath, "Client Error", fmt.Sprintf("Unable to describe schema %s, got error: %s", name, err))
+				continue
+			}
+
+			explicit := model.Tables.Elements()
+			expanded := []string{}
+			for table := range description {
+				if _, ok := explicit[table]; !ok {
+					expanded = append(expanded, table)
+				}
+			}
+
+			var d diag.Diagnostics
+			model.ExpandedTables, d = types.SetValueFrom(ctx, types.StringType, expanded)
+			diags.Append(d...)
 		}
-		perm.AddSchemaPermission(name, schemaPermission)
+
+		if !model.Tables.IsNull() && !model.Tables.IsUnknown() {
+			var d diag.Diagnostics
+			model.Tables, d = r.resolveTablePermissions(ctx, schemaPath.AtName("tables"), name, model.Tables, planning)
+			diags.Append(d...)
+		}
+
+		models[name] = model
+	}
+
+	if diags.HasError() {
+		return permissions, diags
+	}
+
+	return types.MapValueFrom(ctx, types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}, models)
+}
+
+// resolveTablePermissions fills denied_attributes of the table entries of a
+// schema, see resolveSchemaPermissions.
+func (r *RoleResource) resolveTablePermissions(ctx context.Context, p path.Path, schemaName string, tables types.Map, planning bool) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var models map[string]RoleTablePermissionModel
+	diags.Append(tables.ElementsAs(ctx, &models, false)...)
+
+	if diags.HasError() {
+		return tables, diags
+	}
+
+	for name, model := range models {
+		switch {
+		case model.AttributeMode.IsUnknown() || model.AttributePermissions.IsUnknown():
+			model.DeniedAttributes = types.SetUnknown(types.StringType)
+		case model.AttributeMode.ValueString() != attributeModeDenyByDefault:
+			model.DeniedAttributes = types.SetNull(types.StringType)
+		case planning || model.DeniedAttributes.IsUnknown():
+			listed, known := listedAttributes(ctx, model.AttributePermissions)
+			if !known {
+				model.DeniedAttributes = types.SetUnknown(types.StringType)
+				break
+			}
+
+			description, err := r.client.DescribeTable(schemaName, name)
+			if err != nil {
+				if planning && isDoesNotExistError(err) {
+					// The table is created within the same apply.
+					model.DeniedAttributes = types.SetUnknown(types.StringType)
+					break
+				}
+
+				diags.AddAttributeError(p.AtMapKey(name), "Client Error", fmt.Sprintf("Unable to describe table %s.%s, got error: %s", schemaName, name, err))
+				continue
+			}
+
+			denied := []string{}
+			for _, attribute := range description.Attributes {
+				if !listed[attribute.Attribute] && !isSystemAttribute(attribute.Attribute) {
+					denied = append(denied, attribute.Attribute)
+				}
+			}
+
+			var d diag.Diagnostics
+			model.DeniedAttributes, d = types.SetValueFrom(ctx, types.StringType, denied)
+			diags.Append(d...)
+		}
+
+		models[name] = model
+	}
+
+	if diags.HasError() {
+		return tables, diags
+	}
+
+	return types.MapValueFrom(ctx, types.ObjectType{AttrTypes: roleTablePermissionAttrTypes}, models)
+}
+
+// listedAttributes returns the names listed in attribute_permissions. The
+// returned flag is false while any of the names is unknown.
+func listedAttributes(ctx context.Context, value types.Set) (map[string]bool, bool) {
+	listed := map[string]bool{}
+
+	if value.IsNull() {
+		return listed, true
+	}
+
+	var models []RoleAttributePermissionModel
+	if diags := value.ElementsAs(ctx, &models, false); diags.HasError() {
+		return listed, false
+	}
+
+	for _, model := range models {
+		if model.Name.IsUnknown() {
+			return listed, false
+		}
+		listed[model.Name.ValueString()] = true
 	}
 
-	return perm
+	return listed, true
+}
+
+// isDoesNotExistError reports whether err is a HarperDB error about a missing
+// schema, table or record.
+func isDoesNotExistError(err error) bool {
+	var opErr *harperdb.OperationError
+	return errors.As(err, &opErr) && opErr.IsDoesNotExistError()
 }
 
 func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -201,7 +529,26 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	roleName := data.Name.ValueString()
-	perm := r.constructPermission(data)
+
+	permissions, diags := r.resolveSchemaPermissions(ctx, data.SchemaPermissions, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SchemaPermissions = permissions
+	perm, known, diags := r.constructPermission(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !known {
+		resp.Diagnostics.AddError("Unknown Role Permission", "The role permissions are not fully known at apply time. Please report this issue to the provider developers.")
+		return
+	}
 
 	role, err := r.client.AddRole(roleName, perm)
 	if err != nil {
@@ -226,6 +573,102 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	role, err := findRole(r.client, data.ID.ValueString(), false)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return
+	}
+
+	if role == nil {
+		tflog.Warn(ctx, fmt.Sprintf("role %s no longer exists, removing it from state", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(role.ID)
+	data.Name = types.StringValue(role.Role)
+
+	// Roles managed through permission_json keep the document form, semantic
+	// equality takes care of formatting differences.
+	if !data.PermissionJSON.IsNull() {
+		document, err := normalizeRolePermission(role.Permission)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("permission_json"),
+				"Unexpected Role Permission",
+				fmt.Sprintf("Unable to decode the permissions of role %s, got error: %s", role.Role, err),
+			)
+			return
+		}
+
+		data.PermissionJSON = NewPermissionJSONValue(document)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Role flags are only written when set on the server or already tracked,
+	// an omitted flag and false are equivalent.
+	if superUser := permissionFlag(role.Permission, permissionKeySuperUser); superUser || !data.SuperUser.IsNull() {
+		data.SuperUser = types.BoolValue(superUser)
+	}
+	if clusterUser := permissionFlag(role.Permission, permissionKeyClusterUser); clusterUser || !data.ClusterUser.IsNull() {
+		data.ClusterUser = types.BoolValue(clusterUser)
+	}
+
+	structureAll, structureSchemas, err := structureUser(role.Permission[permissionKeyStructureUser])
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("structure_user"),
+			"Unexpected Role Permission",
+			fmt.Sprintf("Unable to decode the permissions of role %s, got error: %s", role.Role, err),
+		)
+		return
+	}
+	if structureAll || !data.StructureUser.IsNull() {
+		data.StructureUser = types.BoolValue(structureAll)
+	}
+	if len(structureSchemas) > 0 {
+		structureSchemasValue, diags := types.SetValueFrom(ctx, types.StringType, structureSchemas)
+		resp.Diagnostics.Append(diags...)
+		data.StructureUserSchemas = structureSchemasValue
+	} else {
+		data.StructureUserSchemas = types.SetNull(types.StringType)
+	}
+
+	schemas, err := schemaPermissions(role.Permission)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schema_permissions"),
+			"Unexpected Role Permission",
+			fmt.Sprintf("Unable to decode the permissions of role %s, got error: %s", role.Role, err),
+		)
+		return
+	}
+
+	// Imported roles take ownership of all of their tables, afterwards only
+	// the tables tracked in the state are reported.
+	imported, diags := req.Private.GetKey(ctx, roleImportedKey)
+	resp.Diagnostics.Append(diags...)
+
+	if string(imported) != "true" {
+		owned, diags := ownedRoleTables(ctx, data.SchemaPermissions)
+		resp.Diagnostics.Append(diags...)
+		schemas = owned.filter(schemas)
+	} else {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, roleImportedKey, []byte("false"))...)
+	}
+
+	permissions, diags := flattenSchemaPermissions(ctx, schemas, data.SchemaPermissions)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SchemaPermissions = permissions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -245,7 +688,67 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	perm := r.constructPermission(data)
+	// Hold the lock across the read-modify-write cycle, grants added by
+	// harperdb_role_table_permission in between would be lost otherwise. See
+	// roleLocks for what it does not cover.
+	roleLocks.Lock(old_data.ID.ValueString())
+	defer roleLocks.Unlock(old_data.ID.ValueString())
+
+	permissions, diags := r.resolveSchemaPermissions(ctx, data.SchemaPermissions, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SchemaPermissions = permissions
+	perm, known, diags := r.constructPermission(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !known {
+		resp.Diagnostics.AddError("Unknown Role Permission", "The role permissions are not fully known at apply time. Please report this issue to the provider developers.")
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, roleImportedKey)
+	resp.Diagnostics.Append(diags...)
+
+	// permission_json replaces the whole document, schema_permissions only the
+	// tables owned by this resource. Roles switching away from permission_json
+	// and imported roles own all of their tables, tables missing from the plan
+	// are revoked.
+	if data.PermissionJSON.IsNull() && old_data.PermissionJSON.IsNull() && string(imported) != "true" {
+		current, err := findRole(r.client, old_data.ID.ValueString(), false)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+			return
+		}
+
+		if current == nil {
+			resp.Diagnostics.AddError("Role Not Found", fmt.Sprintf("The role %s no longer exists.", old_data.ID.ValueString()))
+			return
+		}
+
+		owned, diags := ownedRoleTables(ctx, data.SchemaPermissions, old_data.SchemaPermissions)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if err := preserveUnownedTables(perm, current.Permission, owned); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schema_permissions"),
+				"Unexpected Role Permission",
+				fmt.Sprintf("Unable to decode the permissions of role %s, got error: %s", current.Role, err),
+			)
+			return
+		}
+	}
 
 	role, err := r.client.AlterRole(old_data.ID.ValueString(), data.Name.ValueString(), perm)
 	if err != nil {
@@ -272,15 +775,239 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	id := data.ID.ValueString()
-	err := r.client.DropRole(id)
+
+	roleLocks.Lock(id)
+	defer roleLocks.Unlock(id)
+
+	// HarperDB refuses to drop roles which are still assigned, check first so
+	// that the blocking users can be reported or moved away.
+	users, err := r.client.ListUsers()
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to drop User, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
 		return
 	}
 
-	// This is a state-only resource. It doesn't have a direct analogy in HarperDB.
+	assigned := roleUsers(users, id, data.Name.ValueString())
+	if len(assigned) > 0 {
+		resp.Diagnostics.Append(r.reassignUsers(ctx, data, assigned)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err = r.client.DropRole(id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to drop role, got error: %s", err))
+		return
+	}
 }
 
+// reassignUsers moves the users to the on_destroy_reassign_to role, or reports
+// them when no such role is configured.
+func (r *RoleResource) reassignUsers(ctx context.Context, data *RoleResourceModel, users []harperdb.User) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(users))
+	for _, user := range users {
+		names = append(names, user.Username)
+	}
+	sort.Strings(names)
+
+	if data.OnDestroyReassignTo.IsNull() {
+		diags.AddError(
+			"Role Still Assigned",
+			fmt.Sprintf("The role %s is still assigned to the users %s. Delete these users or assign them another role, "+
+				"or set on_destroy_reassign_to and apply before destroying the role.", data.Name.ValueString(), strings.Join(names, ", ")),
+		)
+		return diags
+	}
+
+	target := data.OnDestroyReassignTo.ValueString()
+	role, err := findRoleByName(r.client, target)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return diags
+	}
+
+	if role == nil || role.ID == data.ID.ValueString() {
+		diags.AddAttributeError(
+			path.Root("on_destroy_reassign_to"),
+			"Invalid Reassignment Role",
+			fmt.Sprintf("The users %s cannot be reassigned to %q, it must be the name of another existing role.", strings.Join(names, ", "), target),
+		)
+		return diags
+	}
+
+	for _, user := range users {
+		// An empty password leaves the password of the user unchanged.
+		if err := r.client.AlterUser(user.Username, "", role.Role, user.Active); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to reassign user %s to role %s, got error: %s", user.Username, role.Role, err))
+			return diags
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("reassigned user %s to role %s", user.Username, role.Role))
+	}
+
+	return diags
+}
+
+// roleUsers returns the users assigned to the role with the given ID or name.
+func roleUsers(users []harperdb.User, id, name string) []harperdb.User {
+	var assigned []harperdb.User
+	for _, user := range users {
+		if user.Role.ID == id || (user.Role.ID == "" && user.Role.Role == name) {
+			assigned = append(assigned, user)
+		}
+	}
+
+	return assigned
+}
+
+// roleTables records the tables owned by a harperdb_role. A nil table set
+// stands for every table of the schema, which is the case for schemas with
+// default_table_permissions.
+type roleTables map[string]map[string]bool
+
+// ownedRoleTables returns the tables listed in any of the schema_permissions
+// values. The remaining tables of the role are left to
+// harperdb_role_table_permission.
+func ownedRoleTables(ctx context.Context, values ...types.Map) (roleTables, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	owned := roleTables{}
+
+	for _, value := range values {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		var models map[string]RoleSchemaPermissionModel
+		diags.Append(value.ElementsAs(ctx, &models, false)...)
+
+		for name, model := range models {
+			if !model.DefaultTablePermissions.IsNull() {
+				owned[name] = nil
+				continue
+			}
+
+			tables, ok := owned[name]
+			if ok && tables == nil {
+				continue
+			}
+			if !ok {
+				tables = map[string]bool{}
+				owned[name] = tables
+			}
+			for table := range model.Tables.Elements() {
+				tables[table] = true
+			}
+		}
+	}
+
+	return owned, diags
+}
+
+// owns reports whether schemaName.table is owned by the role resource.
+func (o roleTables) owns(schemaName, table string) bool {
+	tables, ok := o[schemaName]
+	return ok && (tables == nil || tables[table])
+}
+
+// filter returns the grants of the owned tables.
+func (o roleTables) filter(schemas map[string]harperdb.SchemaPermission) map[string]harperdb.SchemaPermission {
+	filtered := map[string]harperdb.SchemaPermission{}
+	for name, sp := range schemas {
+		tables := map[string]harperdb.TablePermission{}
+		for table, tp := range sp.Tables {
+			if o.owns(name, table) {
+				tables[table] = tp
+			}
+		}
+
+		if len(tables) > 0 {
+			filtered[name] = harperdb.SchemaPermission{Tables: tables}
+		}
+	}
+
+	return filtered
+}
+
+// preserveUnownedTables copies the grants of current which are not owned by
+// the role resource into perm, so that an update of the role keeps the tables
+// granted by harperdb_role_table_permission.
+func preserveUnownedTables(perm, current harperdb.Permission, owned roleTables) error {
+	schemas, err := schemaPermissions(current)
+	if err != nil {
+		return err
+	}
+
+	for name, sp := range schemas {
+		for table, tp := range sp.Tables {
+			if !owned.owns(name, table) {
+				setTablePermission(perm, name, table, tp)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ImportState accepts either the ID or the name of a role. The remaining
+// attributes are hydrated by the subsequent Read.
 func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	role, err := findRole(r.client, req.ID, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return
+	}
+
+	if role == nil {
+		resp.Diagnostics.AddError(
+			"Role Not Found",
+			fmt.Sprintf("No role with the ID or name %q exists.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), role.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), role.Role)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, roleImportedKey, []byte("true"))...)
+}
+
+// findRole returns the role with the given ID, or nil if it does not exist.
+// When byName is set, a role whose name matches is accepted as well; IDs take
+// precedence.
+func findRole(client *harperdb.Client, idOrName string, byName bool) (*harperdb.Role, error) {
+	roles, err := client.ListRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	if role := matchRole(roles, func(role harperdb.Role) bool { return role.ID == idOrName }); role != nil || !byName {
+		return role, nil
+	}
+
+	return matchRole(roles, func(role harperdb.Role) bool { return role.Role == idOrName }), nil
+}
+
+// findRoleByName returns the role with the given name, or nil if it does not
+// exist. IDs are not matched.
+func findRoleByName(client *harperdb.Client, name string) (*harperdb.Role, error) {
+	roles, err := client.ListRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	return matchRole(roles, func(role harperdb.Role) bool { return role.Role == name }), nil
+}
+
+// matchRole returns the first of the roles accepted by match.
+func matchRole(roles []harperdb.Role, match func(harperdb.Role) bool) *harperdb.Role {
+	for i := range roles {
+		if match(roles[i]) {
+			return &roles[i]
+		}
+	}
+
+	return nil
 }
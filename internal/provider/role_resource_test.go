@@ -1,9 +1,610 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccRoleResource(t *testing.T) {
-	t.Skip("not implemented")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceConfig("tf_acc_role", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role.test", "name", "tf_acc_role"),
+					resource.TestCheckResourceAttr("harperdb_role.test", "schema_permissions.tf_acc_role.tables.dogs.read", "true"),
+					resource.TestCheckResourceAttrSet("harperdb_role.test", "id"),
+				),
+			},
+			// Import by ID
+			{
+				ResourceName:      "harperdb_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import by name
+			{
+				ResourceName:      "harperdb_role.test",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_role",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoleResourceConfig("tf_acc_role", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role.test", "schema_permissions.tf_acc_role.tables.dogs.read", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleResourceConfig(name string, read bool) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "harperdb_schema" "test" {
+  name = "%[2]s"
+}
+
+resource "harperdb_table" "test" {
+  schema         = harperdb_schema.test.name
+  name           = "dogs"
+  hash_attribute = "id"
+}
+
+resource "harperdb_role" "test" {
+  name = "%[2]s"
+  schema_permissions = {
+    "${harperdb_schema.test.name}" = {
+      tables = {
+        "${harperdb_table.test.name}" = {
+          read = %[3]t
+          attribute_permissions = [
+            {
+              name = "id"
+              read = true
+            }
+          ]
+        }
+      }
+    }
+  }
+}
+`, testAccProviderTF(), name, read)
+}
+
+func TestAccRoleResource_permissionJSON(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourcePermissionJSONConfig("tf_acc_role_json"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role.test", "name", "tf_acc_role_json"),
+					resource.TestCheckNoResourceAttr("harperdb_role.test", "schema_permissions"),
+				),
+			},
+			// The server representation of the document must not produce a diff.
+			{
+				Config:   testAccRoleResourcePermissionJSONConfig("tf_acc_role_json"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccRoleResourcePermissionJSONConfig(name string) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "harperdb_schema" "test" {
+  name = "%[2]s"
+}
+
+resource "harperdb_table" "test" {
+  schema         = harperdb_schema.test.name
+  name           = "dogs"
+  hash_attribute = "id"
+}
+
+resource "harperdb_role" "test" {
+  name = "%[2]s"
+  permission_json = jsonencode({
+    (harperdb_schema.test.name) = {
+      tables = {
+        (harperdb_table.test.name) = {
+          read   = true
+          insert = false
+          attribute_permissions = [
+            { attribute_name = "name", read = true },
+            { attribute_name = "id", read = true },
+          ]
+        }
+      }
+    }
+  })
+}
+`, testAccProviderTF(), name)
+}
+
+func TestAccRoleResource_conflictingFlags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_role" "test" {
+  name         = "tf_acc_role_conflict"
+  super_user   = true
+  cluster_user = true
+}
+`, testAccProviderTF()),
+				ExpectError: regexp.MustCompile("Conflicting Role Flags"),
+			},
+		},
+	})
+}
+
+func TestAccRoleResource_structureUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceStructureUserConfig("structure_user = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role.test", "structure_user", "true"),
+				),
+			},
+			{
+				Config: testAccRoleResourceStructureUserConfig("structure_user_schemas = [harperdb_schema.test.name]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role.test", "structure_user_schemas.#", "1"),
+					resource.TestCheckTypeSetElemAttr("harperdb_role.test", "structure_user_schemas.*", "tf_acc_structure"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleResourceStructureUserConfig(structureUser string) string {
+	return fmt.Sprintf(`
+	%s
+
+resource "harperdb_schema" "test" {
+  name = "tf_acc_structure"
+}
+
+resource "harperdb_role" "test" {
+  name = "tf_acc_structure"
+  %s
+}
+`, testAccProviderTF(), structureUser)
+}
+
+func TestAccRoleResource_defaultTablePermissions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceDefaultTablePermissionsConfig([]string{"dogs", "breeds"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role.test", "schema_permissions.tf_acc_defaults.tables.dogs.insert", "true"),
+					resource.TestCheckResourceAttr("harperdb_role.test", "schema_permissions.tf_acc_defaults.expanded_tables.#", "1"),
+					resource.TestCheckTypeSetElemAttr("harperdb_role.test", "schema_permissions.tf_acc_defaults.expanded_tables.*", "breeds"),
+				),
+			},
+			// The role is planned before the new table exists, the table shows
+			// up as drift once it has been created.
+			{
+				Config:             testAccRoleResourceDefaultTablePermissionsConfig([]string{"dogs", "breeds", "owners"}),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRoleResourceDefaultTablePermissionsConfig([]string{"dogs", "breeds", "owners"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role.test", "schema_permissions.tf_acc_defaults.expanded_tables.#", "2"),
+					resource.TestCheckTypeSetElemAttr("harperdb_role.test", "schema_permissions.tf_acc_defaults.expanded_tables.*", "owners"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleResourceDefaultTablePermissionsConfig(tables []string) string {
+	return fmt.Sprintf(`
+	%s
+
+resource "harperdb_schema" "test" {
+  name = "tf_acc_defaults"
+}
+
+resource "harperdb_table" "test" {
+  for_each       = toset(split(",", "%s"))
+  schema         = harperdb_schema.test.name
+  name           = each.key
+  hash_attribute = "id"
+}
+
+resource "harperdb_role" "test" {
+  name = "tf_acc_defaults"
+  schema_permissions = {
+    "${harperdb_schema.test.name}" = {
+      tables = {
+        dogs = {
+          read   = true
+          insert = true
+        }
+      }
+      default_table_permissions = {
+        read = true
+      }
+    }
+  }
+
+  depends_on = [harperdb_table.test]
+}
+`, testAccProviderTF(), strings.Join(tables, ","))
+}
+
+func TestAccRoleResource_denyByDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_schema" "test" {
+  name = "tf_acc_deny"
+}
+
+resource "harperdb_table" "test" {
+  schema         = harperdb_schema.test.name
+  name           = "dogs"
+  hash_attribute = "id"
+}
+
+resource "harperdb_role" "test" {
+  name = "tf_acc_deny"
+  schema_permissions = {
+    "${harperdb_schema.test.name}" = {
+      tables = {
+        "${harperdb_table.test.name}" = {
+          read           = true
+          attribute_mode = "deny_by_default"
+        }
+      }
+    }
+  }
+}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role.test", "schema_permissions.tf_acc_deny.tables.dogs.attribute_mode", "deny_by_default"),
+					resource.TestCheckResourceAttr("harperdb_role.test", "schema_permissions.tf_acc_deny.tables.dogs.denied_attributes.#", "1"),
+					resource.TestCheckTypeSetElemAttr("harperdb_role.test", "schema_permissions.tf_acc_deny.tables.dogs.denied_attributes.*", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoleResource_onDestroyReassignTo(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceReassignConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role.old", "on_destroy_reassign_to", "tf_acc_reassign_new"),
+				),
+			},
+			// The user still holds the old role, destroying it moves the user.
+			{
+				Config: testAccRoleResourceReassignConfig(false),
+			},
+		},
+	})
+}
+
+func testAccRoleResourceReassignConfig(withOld bool) string {
+	old, dependsOn := "", "harperdb_role.new"
+	if withOld {
+		old = `
+resource "harperdb_role" "old" {
+  name                   = "tf_acc_reassign_old"
+  on_destroy_reassign_to = harperdb_role.new.name
+}
+`
+		dependsOn += ", harperdb_role.old"
+	}
+
+	return fmt.Sprintf(`
+	%s
+
+resource "harperdb_role" "new" {
+  name = "tf_acc_reassign_new"
+}
+%s
+resource "harperdb_user" "test" {
+  username = "tf_acc_reassign"
+  password = "tf_acc_reassign"
+  role     = "tf_acc_reassign_old"
+
+  lifecycle {
+    ignore_changes = [role]
+  }
+
+  depends_on = [%s]
+}
+`, testAccProviderTF(), old, dependsOn)
+}
+
+func TestRoleUsers(t *testing.T) {
+	users := []harperdb.User{
+		{Username: "ada", Role: harperdb.Role{ID: "1", Role: "dev"}},
+		{Username: "grace", Role: harperdb.Role{ID: "2", Role: "ops"}},
+		{Username: "linus", Role: harperdb.Role{Role: "dev"}},
+	}
+
+	assigned := roleUsers(users, "1", "dev")
+	if len(assigned) != 2 || assigned[0].Username != "ada" || assigned[1].Username != "linus" {
+		t.Errorf("expected ada and linus, got %+v", assigned)
+	}
+}
+
+// TestReassignUsers ensures that on_destroy_reassign_to is resolved by name
+// only, a role whose ID equals the name must not take precedence.
+func TestReassignUsers(t *testing.T) {
+	var assigned []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Operation string `json:"operation"`
+			Role      string `json:"role"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected request body: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch body.Operation {
+		case harperdb.OP_LIST_ROLES:
+			w.Write([]byte(`[{"id": "1", "role": "dev"}, {"id": "ops", "role": "qa"}, {"id": "2", "role": "ops"}]`))
+		case harperdb.OP_ALTER_USER:
+			assigned = append(assigned, body.Role)
+			w.Write([]byte(`{"message": "updated 1 of 1 records"}`))
+		default:
+			t.Errorf("unexpected operation %s", body.Operation)
+		}
+	}))
+	defer server.Close()
+
+	r := &RoleResource{client: harperdb.NewClient(server.URL, "user", "password")}
+	data := &RoleResourceModel{
+		ID:                  types.StringValue("1"),
+		Name:                types.StringValue("dev"),
+		OnDestroyReassignTo: types.StringValue("ops"),
+	}
+	users := []harperdb.User{{Username: "ada", Active: true}}
+
+	if diags := r.reassignUsers(context.Background(), data, users); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if want := []string{"ops"}; !reflect.DeepEqual(assigned, want) {
+		t.Errorf("expected users reassigned to %v, got %v", want, assigned)
+	}
+}
+
+// TestPreserveUnownedTables ensures that updating a role keeps the grants of
+// harperdb_role_table_permission while dropping the tables removed from the
+// configuration.
+func TestPreserveUnownedTables(t *testing.T) {
+	ctx := context.Background()
+	tableType := types.ObjectType{AttrTypes: roleTablePermissionAttrTypes}
+
+	prior := testSchemaPermissionsValue(t, types.MapValueMust(tableType, map[string]attr.Value{
+		"owners": testTablePermissionValue(t, types.BoolValue(true), types.SetNull(attributePermissionsType.ElemType)),
+		"walks":  testTablePermissionValue(t, types.BoolValue(true), types.SetNull(attributePermissionsType.ElemType)),
+	}))
+	plan := testSchemaPermissionsValue(t, types.MapValueMust(tableType, map[string]attr.Value{
+		"owners": testTablePermissionValue(t, types.BoolValue(false), types.SetNull(attributePermissionsType.ElemType)),
+	}))
+
+	owned, diags := ownedRoleTables(ctx, plan, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// The role as returned by list_roles, dogs.breeds is granted by
+	// harperdb_role_table_permission.
+	current := harperdb.Permission{
+		"super_user": false,
+		"dogs": map[string]interface{}{
+			"tables": map[string]interface{}{
+				"owners": map[string]interface{}{"read": true, "insert": false, "update": false, "delete": true, "attribute_permissions": []interface{}{}},
+				"walks":  map[string]interface{}{"read": true, "insert": false, "update": false, "delete": true, "attribute_permissions": []interface{}{}},
+				"breeds": map[string]interface{}{"read": true, "insert": true, "update": false, "delete": false, "attribute_permissions": []interface{}{}},
+			},
+		},
+	}
+
+	perm := harperdb.Permission{}
+	setTablePermission(perm, "dogs", "owners", harperdb.TablePermission{Delete: true})
+
+	if err := preserveUnownedTables(perm, current, owned); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]harperdb.TablePermission{
+		"owners": {Delete: true},
+		"breeds": {Read: true, Insert: true, AttributePermissions: []harperdb.AttributePermissions{}},
+	}
+	if got := perm["dogs"].(harperdb.SchemaPermission).Tables; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected tables %+v, got %+v", want, got)
+	}
+
+	schemas, err := schemaPermissions(current)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := owned.filter(schemas)["dogs"].Tables; len(got) != 2 || got["breeds"].Read {
+		t.Errorf("expected the owned tables owners and walks only, got %+v", got)
+	}
+}
+
+// TestRoleResourceUpdate_ownership ensures that an update keeps the grants of
+// harperdb_role_table_permission, unless the role switches away from
+// permission_json and thereby owns all of its tables.
+func TestRoleResourceUpdate_ownership(t *testing.T) {
+	ctx := context.Background()
+	tableType := types.ObjectType{AttrTypes: roleTablePermissionAttrTypes}
+
+	owners := testSchemaPermissionsValue(t, types.MapValueMust(tableType, map[string]attr.Value{
+		"owners": testTablePermissionValue(t, types.BoolValue(true), types.SetNull(attributePermissionsType.ElemType)),
+	}))
+	document := `{"dogs":{"tables":{"owners":{"read":true,"insert":false,"update":false,"delete":true,"attribute_permissions":[]},` +
+		`"breeds":{"read":true,"insert":false,"update":false,"delete":false,"attribute_permissions":[]}}}}`
+
+	testCases := map[string]struct {
+		prior      RoleResourceModel
+		wantTables []string
+	}{
+		"schema-permissions": {
+			prior: RoleResourceModel{
+				SchemaPermissions: owners,
+				PermissionJSON:    NewPermissionJSONNull(),
+			},
+			wantTables: []string{"breeds", "owners"},
+		},
+		"from-permission-json": {
+			prior: RoleResourceModel{
+				SchemaPermissions: types.MapNull(types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}),
+				PermissionJSON:    NewPermissionJSONValue(document),
+			},
+			wantTables: []string{"owners"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var altered map[string]json.RawMessage
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Operation  string                     `json:"operation"`
+					Permission map[string]json.RawMessage `json:"permission"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("unexpected request body: %s", err)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				switch body.Operation {
+				case harperdb.OP_LIST_ROLES:
+					// dogs.breeds was granted outside of this role resource.
+					w.Write([]byte(`[{"id": "1", "role": "dev", "permission": ` + document + `}]`))
+				case harperdb.OP_ALTER_ROLE:
+					altered = body.Permission
+					w.Write([]byte(`{"id": "1", "role": "dev"}`))
+				default:
+					t.Errorf("unexpected operation %s", body.Operation)
+				}
+			}))
+			defer server.Close()
+
+			r := &RoleResource{client: harperdb.NewClient(server.URL, "user", "password")}
+
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			model := func(m RoleResourceModel) RoleResourceModel {
+				m.ID = types.StringValue("1")
+				m.Name = types.StringValue("dev")
+				m.StructureUserSchemas = types.SetNull(types.StringType)
+				return m
+			}
+
+			req := fwresource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+				State: tfsdk.State{Schema: schemaResp.Schema},
+			}
+			diags := req.Plan.Set(ctx, model(RoleResourceModel{SchemaPermissions: owners, PermissionJSON: NewPermissionJSONNull()}))
+			diags.Append(req.State.Set(ctx, model(tc.prior))...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Update(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var schema harperdb.SchemaPermission
+			if err := json.Unmarshal(altered["dogs"], &schema); err != nil {
+				t.Fatalf("unexpected permission %s: %s", altered["dogs"], err)
+			}
+
+			var tables []string
+			for table := range schema.Tables {
+				tables = append(tables, table)
+			}
+			sort.Strings(tables)
+
+			if !reflect.DeepEqual(tables, tc.wantTables) {
+				t.Errorf("expected tables %v, got %v", tc.wantTables, tables)
+			}
+		})
+	}
+}
+
+// TestRoleResourceSchema ensures the schema_permissions entries of the
+// schema match the types the permissions are decoded with.
+func TestRoleResourceSchema(t *testing.T) {
+	var resp fwresource.SchemaResponse
+	(&RoleResource{}).Schema(context.Background(), fwresource.SchemaRequest{}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	got := resp.Schema.Attributes["schema_permissions"].GetType()
+	want := types.MapType{ElemType: types.ObjectType{AttrTypes: roleSchemaPermissionAttrTypes}}
+	if !got.Equal(want) {
+		t.Errorf("expected schema_permissions of type %s, got %s", want, got)
+	}
 }
@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleTablePermissionResource{}
+var _ resource.ResourceWithImportState = &RoleTablePermissionResource{}
+
+func NewRoleTablePermissionResource() resource.Resource {
+	return &RoleTablePermissionResource{}
+}
+
+// RoleTablePermissionResource defines the resource implementation.
+// It manages a single table grant inside the permission document of a role.
+type RoleTablePermissionResource struct {
+	client *harperdb.Client
+}
+
+// RoleTablePermissionResourceModel describes the resource data model.
+type RoleTablePermissionResourceModel struct {
+	ID                   types.String `tfsdk:"id"` // <role_id>/<schema>/<table>
+	RoleID               types.String `tfsdk:"role_id"`
+	Schema               types.String `tfsdk:"schema"`
+	Table                types.String `tfsdk:"table"`
+	Read                 types.Bool   `tfsdk:"read"`
+	Insert               types.Bool   `tfsdk:"insert"`
+	Update               types.Bool   `tfsdk:"update"`
+	Delete               types.Bool   `tfsdk:"delete"`
+	AttributePermissions types.Set    `tfsdk:"attribute_permissions"`
+}
+
+func (r *RoleTablePermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_table_permission"
+}
+
+func (r *RoleTablePermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Grants permissions on a single table to a role managed elsewhere. " +
+			"The table must not be owned by the `schema_permissions` of the `harperdb_role`, that is neither listed in `tables` " +
+			"nor part of a schema with `default_table_permissions`. Updates of the role keep the grant.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Role ID, schema and table separated by `/`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Role",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Schema of the table",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table": schema.StringAttribute{
+				MarkdownDescription: "Name of the table",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"read": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"insert": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"update": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"delete": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"attribute_permissions": attributePermissionsSchema(),
+		},
+	}
+}
+
+func (r *RoleTablePermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// grant builds the table permission from the model. The returned flag is
+// false while any part of it is unknown.
+func (m *RoleTablePermissionResourceModel) grant(ctx context.Context) (harperdb.TablePermission, bool, diag.Diagnostics) {
+	attributes, known, diags := expandAttributePermissions(ctx, path.Root("attribute_permissions"), m.AttributePermissions)
+
+	return harperdb.TablePermission{
+		Read:                 m.Read.ValueBool(),
+		Insert:               m.Insert.ValueBool(),
+		Update:               m.Update.ValueBool(),
+		Delete:               m.Delete.ValueBool(),
+		AttributePermissions: attributes,
+	}, known && allKnown(m.Read, m.Insert, m.Update, m.Delete), diags
+}
+
+func (r *RoleTablePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RoleTablePermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant, known, diags := data.grant(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !known {
+		resp.Diagnostics.AddError("Unknown Table Permission", "The table permission is not fully known at apply time. Please report this issue to the provider developers.")
+		return
+	}
+
+	schemaName, table := data.Schema.ValueString(), data.Table.ValueString()
+	err := r.modifyRole(data.RoleID.ValueString(), func(perm harperdb.Permission) error {
+		if _, ok := tablePermission(perm, schemaName, table); ok {
+			return fmt.Errorf("the role already grants permissions on %s.%s, import them instead", schemaName, table)
+		}
+
+		setTablePermission(perm, schemaName, table, grant)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create role table permission, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join([]string{data.RoleID.ValueString(), schemaName, table}, "/"))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a Role table permission resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleTablePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *RoleTablePermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := findRole(r.client, data.RoleID.ValueString(), false)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return
+	}
+
+	var grant harperdb.TablePermission
+	found := false
+	if role != nil {
+		perm, err := typedRolePermission(role.Permission)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected Role Permission",
+				fmt.Sprintf("Unable to decode the permissions of role %s, got error: %s", role.Role, err),
+			)
+			return
+		}
+
+		grant, found = tablePermission(perm, data.Schema.ValueString(), data.Table.ValueString())
+	}
+
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("table permission %s no longer exists, removing it from state", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	attributes, diags := flattenAttributePermissions(ctx, grant.AttributePermissions)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Read = types.BoolValue(grant.Read)
+	data.Insert = types.BoolValue(grant.Insert)
+	data.Update = types.BoolValue(grant.Update)
+	data.Delete = types.BoolValue(grant.Delete)
+	data.AttributePermissions = attributes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleTablePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *RoleTablePermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant, known, diags := data.grant(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !known {
+		resp.Diagnostics.AddError("Unknown Table Permission", "The table permission is not fully known at apply time. Please report this issue to the provider developers.")
+		return
+	}
+
+	err := r.modifyRole(data.RoleID.ValueString(), func(perm harperdb.Permission) error {
+		setTablePermission(perm, data.Schema.ValueString(), data.Table.ValueString(), grant)
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update role table permission, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleTablePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RoleTablePermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.modifyRole(data.RoleID.ValueString(), func(perm harperdb.Permission) error {
+		removeTablePermission(perm, data.Schema.ValueString(), data.Table.ValueString())
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role table permission, got error: %s", err))
+		return
+	}
+}
+
+// ImportState accepts an ID of the form <role_id>/<schema>/<table>. The role
+// may be given by name as well.
+func (r *RoleTablePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier of the form <role_id>/<schema>/<table>, got: %q", req.ID),
+		)
+		return
+	}
+
+	role, err := findRole(r.client, parts[0], true)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return
+	}
+
+	if role == nil {
+		resp.Diagnostics.AddError(
+			"Role Not Found",
+			fmt.Sprintf("No role with the ID or name %q exists.", parts[0]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join([]string{role.ID, parts[1], parts[2]}, "/"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), role.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schema"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("table"), parts[2])...)
+}
+
+// modifyRole applies modify to the permission document of the role and stores
+// the result. The role is locked for the whole read-modify-write cycle, so that
+// grants on the same role applied in parallel do not overwrite each other.
+func (r *RoleTablePermissionResource) modifyRole(roleID string, modify func(perm harperdb.Permission) error) error {
+	roleLocks.Lock(roleID)
+	defer roleLocks.Unlock(roleID)
+
+	role, err := findRole(r.client, roleID, false)
+	if err != nil {
+		return err
+	}
+
+	if role == nil {
+		return fmt.Errorf("role %s does not exist", roleID)
+	}
+
+	perm, err := typedRolePermission(role.Permission)
+	if err != nil {
+		return err
+	}
+
+	if err := modify(perm); err != nil {
+		return err
+	}
+
+	_, err = r.client.AlterRole(role.ID, role.Role, perm)
+	return err
+}
+
+// tablePermission returns the grant of schemaName.table in a permission
+// document returned by typedRolePermission.
+func tablePermission(perm harperdb.Permission, schemaName, table string) (harperdb.TablePermission, bool) {
+	sp, ok := perm[schemaName].(harperdb.SchemaPermission)
+	if !ok {
+		return harperdb.TablePermission{}, false
+	}
+
+	tp, ok := sp.Tables[table]
+	return tp, ok
+}
+
+// setTablePermission replaces the grant of schemaName.table.
+func setTablePermission(perm harperdb.Permission, schemaName, table string, grant harperdb.TablePermission) {
+	sp, ok := perm[schemaName].(harperdb.SchemaPermission)
+	if !ok || sp.Tables == nil {
+		sp = harperdb.SchemaPermission{Tables: map[string]harperdb.TablePermission{}}
+	}
+
+	sp.AddTablePermission(table, grant)
+	perm.AddSchemaPermission(schemaName, sp)
+}
+
+// removeTablePermission removes the grant of schemaName.table, dropping the
+// schema entry once no table is left.
+func removeTablePermission(perm harperdb.Permission, schemaName, table string) {
+	sp, ok := perm[schemaName].(harperdb.SchemaPermission)
+	if !ok {
+		return
+	}
+
+	delete(sp.Tables, table)
+	if len(sp.Tables) == 0 {
+		delete(perm, schemaName)
+	}
+}
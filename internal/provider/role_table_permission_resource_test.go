@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleTablePermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleTablePermissionResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role_table_permission.dogs", "read", "true"),
+					resource.TestCheckResourceAttr("harperdb_role_table_permission.breeds", "insert", "true"),
+					resource.TestCheckResourceAttrSet("harperdb_role_table_permission.dogs", "id"),
+				),
+			},
+			{
+				ResourceName:      "harperdb_role_table_permission.dogs",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Both grants are applied in parallel, neither may be lost.
+			{
+				Config: testAccRoleTablePermissionResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("harperdb_role_table_permission.dogs", "update", "true"),
+					resource.TestCheckResourceAttr("harperdb_role_table_permission.breeds", "update", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoleTablePermissionResourceConfig(update bool) string {
+	return fmt.Sprintf(`
+	%[1]s
+
+resource "harperdb_schema" "test" {
+  name = "tf_acc_table_grants"
+}
+
+resource "harperdb_table" "dogs" {
+  schema         = harperdb_schema.test.name
+  name           = "dogs"
+  hash_attribute = "id"
+}
+
+resource "harperdb_table" "breeds" {
+  schema         = harperdb_schema.test.name
+  name           = "breeds"
+  hash_attribute = "id"
+}
+
+resource "harperdb_role" "test" {
+  name = "tf_acc_table_grants"
+
+  lifecycle {
+    ignore_changes = [schema_permissions]
+  }
+}
+
+resource "harperdb_role_table_permission" "dogs" {
+  role_id = harperdb_role.test.id
+  schema  = harperdb_schema.test.name
+  table   = harperdb_table.dogs.name
+  read    = true
+  update  = %[2]t
+  attribute_permissions = [
+    {
+      name = "id"
+      read = true
+    }
+  ]
+}
+
+resource "harperdb_role_table_permission" "breeds" {
+  role_id = harperdb_role.test.id
+  schema  = harperdb_schema.test.name
+  table   = harperdb_table.breeds.name
+  insert  = true
+  update  = %[2]t
+}
+`, testAccProviderTF(), update)
+}
+
+func TestSetTablePermission(t *testing.T) {
+	perm := harperdb.Permission{}
+	perm.SetSuperUser(false)
+
+	setTablePermission(perm, "dev", "dogs", harperdb.TablePermission{Read: true})
+	setTablePermission(perm, "dev", "breeds", harperdb.TablePermission{Insert: true})
+
+	if tp, ok := tablePermission(perm, "dev", "dogs"); !ok || !tp.Read {
+		t.Errorf("expected read on dev.dogs, got %+v", perm)
+	}
+
+	removeTablePermission(perm, "dev", "dogs")
+	if _, ok := tablePermission(perm, "dev", "dogs"); ok {
+		t.Errorf("expected dev.dogs to be removed, got %+v", perm)
+	}
+	if _, ok := tablePermission(perm, "dev", "breeds"); !ok {
+		t.Errorf("expected dev.breeds to be kept, got %+v", perm)
+	}
+
+	removeTablePermission(perm, "dev", "breeds")
+	if _, ok := perm["dev"]; ok {
+		t.Errorf("expected the empty schema entry to be removed, got %+v", perm)
+	}
+	if _, ok := perm[permissionKeySuperUser]; !ok {
+		t.Errorf("expected role flags to be kept, got %+v", perm)
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RolesDataSource{}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+// RolesDataSource defines the data source implementation.
+type RolesDataSource struct {
+	client *harperdb.Client
+}
+
+// RolesDataSourceModel describes the data source data model.
+type RolesDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Names types.List   `tfsdk:"names"`
+	Roles types.List   `tfsdk:"roles"`
+}
+
+func (d *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists all roles, including the built-in `super_user` and `cluster_user` roles",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Hash of the role IDs",
+				Computed:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Sorted role names",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "Roles sorted by name, in the shape of the `harperdb_role` data source",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: roleDataSourceAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RolesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.client.ListRoles()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list roles, got error: %s", err))
+		return
+	}
+
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Role < roles[j].Role
+	})
+
+	names := make([]string, 0, len(roles))
+	ids := make([]string, 0, len(roles))
+	models := make([]RoleDataSourceModel, 0, len(roles))
+	for _, role := range roles {
+		model, diags := flattenRole(ctx, role)
+		resp.Diagnostics.Append(diags...)
+
+		names = append(names, role.Role)
+		ids = append(ids, role.ID)
+		models = append(models, model)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namesValue, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	rolesValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: roleDataSourceAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(ids, ",")))))
+	data.Names = namesValue
+	data.Roles = rolesValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
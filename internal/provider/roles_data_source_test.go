@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceConfig("tf_acc_roles_ds", true) + `
+data "harperdb_roles" "test" {
+  depends_on = [harperdb_role.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.harperdb_roles.test", "names.*", "tf_acc_roles_ds"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.harperdb_roles.test", "roles.*", map[string]string{
+						"name":       "tf_acc_roles_ds",
+						"super_user": "false",
+						"schema_permissions.tf_acc_roles_ds.tables.dogs.read": "true",
+					}),
+				),
+			},
+		},
+	})
+}
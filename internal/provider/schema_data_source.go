@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SchemaDataSource{}
+
+func NewSchemaDataSource() datasource.DataSource {
+	return &SchemaDataSource{}
+}
+
+// SchemaDataSource defines the data source implementation.
+type SchemaDataSource struct {
+	client *harperdb.Client
+}
+
+// SchemaDataSourceModel describes the data source data model.
+type SchemaDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Tables types.Map    `tfsdk:"tables"`
+}
+
+// SchemaTableModel describes a single entry of tables.
+type SchemaTableModel struct {
+	HashAttribute types.String `tfsdk:"hash_attribute"`
+}
+
+var schemaTableAttrTypes = map[string]attr.Type{
+	"hash_attribute": types.StringType,
+}
+
+// schemaTablesSchema returns the tables attribute shared by the schema data
+// sources.
+func schemaTablesSchema() schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		MarkdownDescription: "Tables of the schema keyed by name",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"hash_attribute": schema.StringAttribute{
+					MarkdownDescription: "Primary key of the table",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *SchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema"
+}
+
+func (d *SchemaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a schema and its tables",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Name of the schema",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the schema",
+				Required:            true,
+			},
+			"tables": schemaTablesSchema(),
+		},
+	}
+}
+
+func (d *SchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SchemaDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	description, err := d.client.DescribeSchema(name)
+	if err != nil {
+		if isDoesNotExistError(err) {
+			resp.Diagnostics.AddError("Schema Not Found", fmt.Sprintf("No schema with the name %q exists.", name))
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe schema, got error: %s", err))
+		return
+	}
+
+	tables, err := describeSchemaTables(description)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode schema %s, got error: %s", name, err))
+		return
+	}
+
+	value, diags := flattenSchemaTables(ctx, tables)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Name
+	data.Tables = value
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// describeSchemaTables decodes the generic describe_schema response into the
+// typed table descriptions.
+func describeSchemaTables(description harperdb.DescribeSchemaResponse) (map[string]harperdb.DescribeTableResponse, error) {
+	b, err := json.Marshal(description)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables map[string]harperdb.DescribeTableResponse
+	if err := json.Unmarshal(b, &tables); err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+// flattenSchemaTables converts table descriptions into the tables attribute
+// value. Schemas without tables yield an empty map.
+func flattenSchemaTables(ctx context.Context, tables map[string]harperdb.DescribeTableResponse) (types.Map, diag.Diagnostics) {
+	models := make(map[string]SchemaTableModel, len(tables))
+	for name, table := range tables {
+		models[name] = SchemaTableModel{
+			HashAttribute: types.StringValue(table.HashAttribute),
+		}
+	}
+
+	return types.MapValueFrom(ctx, types.ObjectType{AttrTypes: schemaTableAttrTypes}, models)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_schema" "test" {
+  name = "tf_acc_schema_ds"
+}
+
+resource "harperdb_table" "test" {
+  schema         = harperdb_schema.test.name
+  name           = "dogs"
+  hash_attribute = "dog_id"
+}
+
+data "harperdb_schema" "test" {
+  name = harperdb_table.test.schema
+}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harperdb_schema.test", "id", "tf_acc_schema_ds"),
+					resource.TestCheckResourceAttr("data.harperdb_schema.test", "tables.%", "1"),
+					resource.TestCheckResourceAttr("data.harperdb_schema.test", "tables.dogs.hash_attribute", "dog_id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_schema" "missing" {
+  name = "tf_acc_schema_ds_missing"
+}
+`, testAccProviderTF()),
+				ExpectError: regexp.MustCompile("Schema Not Found"),
+			},
+		},
+	})
+}
+
+func TestDescribeSchemaTables(t *testing.T) {
+	tables, err := describeSchemaTables(harperdb.DescribeSchemaResponse{
+		"dogs": map[string]interface{}{
+			"hash_attribute": "dog_id",
+			"name":           "dogs",
+			"schema":         "dev",
+			"attributes":     []interface{}{map[string]interface{}{"attribute": "dog_id"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := tables["dogs"].HashAttribute; got != "dog_id" {
+		t.Errorf("expected hash attribute dog_id, got %q", got)
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SchemasDataSource{}
+var _ validator.String = regexpValidator{}
+
+func NewSchemasDataSource() datasource.DataSource {
+	return &SchemasDataSource{}
+}
+
+// SchemasDataSource defines the data source implementation.
+type SchemasDataSource struct {
+	client *harperdb.Client
+}
+
+// SchemasDataSourceModel describes the data source data model.
+type SchemasDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Names     types.List   `tfsdk:"names"`
+	Schemas   types.List   `tfsdk:"schemas"`
+}
+
+// SchemasSchemaModel describes a single entry of schemas.
+type SchemasSchemaModel struct {
+	Name   types.String `tfsdk:"name"`
+	Tables types.Map    `tfsdk:"tables"`
+}
+
+var schemasSchemaAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"tables": types.MapType{ElemType: types.ObjectType{AttrTypes: schemaTableAttrTypes}},
+}
+
+func (d *SchemasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schemas"
+}
+
+func (d *SchemasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the schemas and their tables",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Name filter of the lookup, `schemas` without a filter",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression the schema names must match, in the syntax of Go's `regexp` package",
+				Optional:            true,
+				Validators: []validator.String{
+					regexpValidator{},
+				},
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Sorted names of the matching schemas",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"schemas": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching schemas sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the schema",
+							Computed:            true,
+						},
+						"tables": schemaTablesSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SchemasDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("The value is not a valid regular expression: %s", err),
+			)
+			return
+		}
+	}
+
+	all, err := d.client.DescribeAll()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe schemas, got error: %s", err))
+		return
+	}
+
+	names := []string{}
+	for name := range *all {
+		if nameRegex == nil || nameRegex.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	schemas := make([]SchemasSchemaModel, 0, len(names))
+	for _, name := range names {
+		tables, diags := flattenSchemaTables(ctx, (*all)[name])
+		resp.Diagnostics.Append(diags...)
+
+		schemas = append(schemas, SchemasSchemaModel{
+			Name:   types.StringValue(name),
+			Tables: tables,
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namesValue, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	schemasValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemasSchemaAttrTypes}, schemas)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID must not be empty, unfiltered lookups get a fixed one.
+	data.ID = types.StringValue("schemas")
+	if !data.NameRegex.IsNull() {
+		data.ID = data.NameRegex
+	}
+	data.Names = namesValue
+	data.Schemas = schemasValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// regexpValidator ensures a string is a valid regular expression.
+type regexpValidator struct{}
+
+func (v regexpValidator) Description(ctx context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexpValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexpValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("The value is not a valid regular expression: %s", err),
+		)
+	}
+}
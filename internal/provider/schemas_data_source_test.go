@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemasDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_schema" "a" {
+  name = "tf_acc_schemas_a"
+}
+
+resource "harperdb_schema" "b" {
+  name = "tf_acc_schemas_b"
+}
+
+data "harperdb_schemas" "test" {
+  name_regex = "^tf_acc_schemas_"
+
+  depends_on = [harperdb_schema.a, harperdb_schema.b]
+}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harperdb_schemas.test", "id", "^tf_acc_schemas_"),
+					resource.TestCheckResourceAttr("data.harperdb_schemas.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.harperdb_schemas.test", "names.0", "tf_acc_schemas_a"),
+					resource.TestCheckResourceAttr("data.harperdb_schemas.test", "schemas.1.name", "tf_acc_schemas_b"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_schemas" "test" {}
+`, testAccProviderTF()),
+				Check: resource.TestCheckResourceAttr("data.harperdb_schemas.test", "id", "schemas"),
+			},
+		},
+	})
+}
+
+func TestRegexpValidator(t *testing.T) {
+	testCases := map[string]struct {
+		value     types.String
+		wantError bool
+	}{
+		"null":    {value: types.StringNull()},
+		"unknown": {value: types.StringUnknown()},
+		"valid":   {value: types.StringValue("^dev_.*$")},
+		"invalid": {value: types.StringValue("dev_("), wantError: true},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			regexpValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("name_regex"),
+				ConfigValue: tc.value,
+			}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantError {
+				t.Errorf("expected error %t, got diagnostics: %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// sqlPlaceholder marks a bound parameter in a query.
+const sqlPlaceholder = '?'
+
+// sqlLimitKeyword matches the LIMIT keyword at the start of the input.
+var sqlLimitKeyword = regexp.MustCompile(`(?i)^limit\b`)
+
+// sqlLimitPattern matches a LIMIT clause with number literals, capturing the
+// count or the offset and count.
+var sqlLimitPattern = regexp.MustCompile(`(?i)^limit\s+(\d+)(?:\s*,\s*(\d+))?\b`)
+
+// unquotedSQL calls fn with the index of every byte of query which is neither
+// part of a string literal, a quoted identifier nor a comment.
+func unquotedSQL(query string, fn func(i int)) {
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; {
+		case c == '\'' || c == '"' || c == '`' || c == '[':
+			end := c
+			if c == '[' {
+				end = ']'
+			}
+
+			// Skip to the closing quote. Quotes are escaped by doubling them,
+			// which is handled by reentering the literal on the next pass.
+			i++
+			for i < len(query) && query[i] != end {
+				if query[i] == '\\' && end != ']' {
+					i++
+				}
+				i++
+			}
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			for i+1 < len(query) && query[i+1] != '\n' {
+				i++
+			}
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return
+			}
+			i += end + 3
+		default:
+			fn(i)
+		}
+	}
+}
+
+// checkSelectStatement ensures query is a single SELECT statement. A trailing
+// semicolon is allowed.
+func checkSelectStatement(query string) error {
+	var code strings.Builder
+	unquotedSQL(query, func(i int) {
+		code.WriteByte(query[i])
+	})
+
+	statement := strings.TrimRightFunc(code.String(), func(r rune) bool {
+		return r == ';' || unicode.IsSpace(r)
+	})
+	statement = strings.TrimSpace(statement)
+
+	if strings.Contains(statement, ";") {
+		return errors.New("only a single statement is allowed")
+	}
+
+	keyword := statement
+	if end := strings.IndexFunc(statement, func(r rune) bool { return !unicode.IsLetter(r) }); end >= 0 {
+		keyword = statement[:end]
+	}
+	if !strings.EqualFold(keyword, "select") {
+		return errors.New("only SELECT statements are allowed")
+	}
+
+	return nil
+}
+
+// bindSQLParameters replaces each placeholder of query with the respective
+// parameter as string literal.
+func bindSQLParameters(query string, parameters []string) (string, error) {
+	var placeholders []int
+	unquotedSQL(query, func(i int) {
+		if query[i] == sqlPlaceholder {
+			placeholders = append(placeholders, i)
+		}
+	})
+
+	if len(placeholders) != len(parameters) {
+		return "", fmt.Errorf("the query has %d placeholders but %d parameters are given", len(placeholders), len(parameters))
+	}
+
+	var b strings.Builder
+	last := 0
+	for n, i := range placeholders {
+		// A backslash escapes the following character in HarperDB string
+		// literals, so it could end the literal early.
+		if strings.ContainsRune(parameters[n], '\\') {
+			return "", fmt.Errorf("parameter %d contains a backslash, which cannot be bound safely", n+1)
+		}
+
+		b.WriteString(query[last:i])
+		b.WriteString("'" + strings.ReplaceAll(parameters[n], "'", "''") + "'")
+		last = i + 1
+	}
+	b.WriteString(query[last:])
+
+	return b.String(), nil
+}
+
+// limitSQLQuery restricts the SELECT statement query to at most limit rows.
+// LIMIT is appended to queries without one, the count of an existing LIMIT
+// is lowered to limit. A LIMIT whose count is not a number literal is kept
+// as-is. Trailing semicolons are removed, LIMIT is put on a new line in case
+// the query ends with a comment.
+func limitSQLQuery(query string, limit int64) string {
+	query, code := trimSQLSemicolons(query)
+
+	// Only a LIMIT outside of parentheses applies to the whole result.
+	clause := -1
+	depth := 0
+	for i := range code {
+		switch code[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+
+		if depth == 0 && sqlLimitKeyword.Match(code[i:]) && (i == 0 || !isSQLIdentifierByte(code[i-1])) {
+			clause = i
+		}
+	}
+
+	if clause < 0 {
+		return fmt.Sprintf("%s\nLIMIT %d", query, limit)
+	}
+
+	// LIMIT count, or LIMIT offset, count.
+	m := sqlLimitPattern.FindSubmatchIndex(code[clause:])
+	if m == nil {
+		return query
+	}
+
+	start, end := clause+m[2], clause+m[3]
+	if m[4] >= 0 {
+		start, end = clause+m[4], clause+m[5]
+	}
+
+	count, err := strconv.ParseInt(query[start:end], 10, 64)
+	if err != nil || count <= limit {
+		return query
+	}
+
+	return query[:start] + strconv.FormatInt(limit, 10) + query[end:]
+}
+
+// trimSQLSemicolons removes trailing semicolons from query. It returns the
+// query along with a copy in which literals and comments are masked with
+// spaces, so that only code is matched.
+func trimSQLSemicolons(query string) (string, []byte) {
+	for {
+		code := []byte(strings.Repeat(" ", len(query)))
+		last := -1
+		unquotedSQL(query, func(i int) {
+			code[i] = query[i]
+			if !unicode.IsSpace(rune(query[i])) {
+				last = i
+			}
+		})
+
+		if last < 0 || query[last] != ';' {
+			return query, code
+		}
+		query = query[:last] + query[last+1:]
+	}
+}
+
+// isSQLIdentifierByte reports whether c may be part of an identifier.
+func isSQLIdentifierByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z'
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultSQLQueryMaxRows is the row limit of harperdb_sql_query when
+// max_rows is not set.
+const defaultSQLQueryMaxRows = 1000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SQLQueryDataSource{}
+var _ validator.String = selectStatementValidator{}
+
+func NewSQLQueryDataSource() datasource.DataSource {
+	return &SQLQueryDataSource{}
+}
+
+// SQLQueryDataSource defines the data source implementation.
+type SQLQueryDataSource struct {
+	client *harperdb.Client
+}
+
+// SQLQueryDataSourceModel describes the data source data model.
+type SQLQueryDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Query      types.String `tfsdk:"query"`
+	Parameters types.List   `tfsdk:"parameters"`
+	MaxRows    types.Int64  `tfsdk:"max_rows"`
+	Rows       types.List   `tfsdk:"rows"`
+	JSON       types.String `tfsdk:"json"`
+}
+
+func (d *SQLQueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_query"
+}
+
+func (d *SQLQueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Runs a read-only SQL query",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Hash of the query after binding the parameters",
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "A single `SELECT` statement. Use `?` as placeholder for each of `parameters`.",
+				Required:            true,
+				Validators: []validator.String{
+					selectStatementValidator{},
+				},
+			},
+			"parameters": schema.ListAttribute{
+				MarkdownDescription: "Values bound to the placeholders of `query`, in order, as string literals. " +
+					"Values containing a backslash are rejected since they cannot be bound safely.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"max_rows": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of rows. The query is limited on the server to one row more by adding a `LIMIT` "+
+					"or lowering the count of its own, reading fails when that row is returned. Defaults to `%d`.", defaultSQLQueryMaxRows),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rows": schema.ListAttribute{
+				MarkdownDescription: "Returned rows keyed by column. Strings are returned as-is, numbers and booleans in their JSON representation, " +
+					"objects and arrays JSON encoded and SQL `NULL` as null.",
+				Computed:    true,
+				ElementType: types.MapType{ElemType: types.StringType},
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded result",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SQLQueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SQLQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SQLQueryDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The validator skips queries which are unknown while validating.
+	if err := checkSelectStatement(data.Query.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("query"), "Invalid SQL Statement", fmt.Sprintf("The query is not allowed: %s.", err))
+		return
+	}
+
+	var parameters []string
+	if !data.Parameters.IsNull() {
+		resp.Diagnostics.Append(data.Parameters.ElementsAs(ctx, &parameters, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	query, err := bindSQLParameters(data.Query.ValueString(), parameters)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid Parameters", fmt.Sprintf("Unable to bind the parameters: %s.", err))
+		return
+	}
+
+	maxRows := int64(defaultSQLQueryMaxRows)
+	if !data.MaxRows.IsNull() {
+		maxRows = data.MaxRows.ValueInt64()
+	}
+
+	// Fetch one row more than allowed, so that exceeding max_rows is detected
+	// without transferring the whole result.
+	var raw json.RawMessage
+	if err := d.client.SQLSelect(&raw, "%s", limitSQLQuery(query, maxRows+1)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run query, got error: %s", err))
+		return
+	}
+
+	var rows []map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&rows); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode query result, got error: %s", err))
+		return
+	}
+
+	if int64(len(rows)) > maxRows {
+		resp.Diagnostics.AddError(
+			"Too Many Rows",
+			fmt.Sprintf("The query returned more than the maximum of %d rows. Restrict the query or raise max_rows.", maxRows),
+		)
+		return
+	}
+
+	rowValues := make([]attr.Value, 0, len(rows))
+	for _, row := range rows {
+		columns := make(map[string]attr.Value, len(row))
+		for column, value := range row {
+			columns[column] = sqlValueString(value)
+		}
+
+		rowValue, diags := types.MapValue(types.StringType, columns)
+		resp.Diagnostics.Append(diags...)
+		rowValues = append(rowValues, rowValue)
+	}
+
+	rowsValue, diags := types.ListValue(types.MapType{ElemType: types.StringType}, rowValues)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%x", sha256.Sum256([]byte(query))))
+	data.Rows = rowsValue
+	data.JSON = rawJSONValue(raw)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// sqlValueString converts a column value decoded with json.Decoder.UseNumber
+// into a string, see jsonValueString. SQL NULL yields null.
+func sqlValueString(value interface{}) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(jsonValueString(value))
+}
+
+// jsonValueString renders a value decoded with json.Decoder.UseNumber as
+// string: strings as-is, everything else in its JSON representation.
+func jsonValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
+
+// selectStatementValidator ensures a string is a single SELECT statement.
+type selectStatementValidator struct{}
+
+func (v selectStatementValidator) Description(ctx context.Context) string {
+	return "value must be a single SELECT statement"
+}
+
+func (v selectStatementValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v selectStatementValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkSelectStatement(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid SQL Statement",
+			fmt.Sprintf("The query is not allowed: %s.", err),
+		)
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSQLQueryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_sql_query" "test" {
+  query      = "SELECT ? AS name, 1 + 1 AS two"
+  parameters = ["O'Brien"]
+}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harperdb_sql_query.test", "rows.#", "1"),
+					resource.TestCheckResourceAttr("data.harperdb_sql_query.test", "rows.0.name", "O'Brien"),
+					resource.TestCheckResourceAttr("data.harperdb_sql_query.test", "rows.0.two", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_sql_query" "test" {
+  query = "DELETE FROM dev.dogs"
+}
+`, testAccProviderTF()),
+				ExpectError: regexp.MustCompile("Invalid SQL Statement"),
+			},
+		},
+	})
+}
+
+func TestSQLValueString(t *testing.T) {
+	var row map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(`{"s": "a", "n": 12345678901234567890, "f": 1.5, "b": true, "o": {"a": [1]}, "z": null}`))
+	decoder.UseNumber()
+	if err := decoder.Decode(&row); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]string{
+		"s": "a",
+		"n": "12345678901234567890",
+		"f": "1.5",
+		"b": "true",
+		"o": `{"a":[1]}`,
+	}
+	for column, value := range want {
+		if got := sqlValueString(row[column]).ValueString(); got != value {
+			t.Errorf("%s: expected %s, got %s", column, value, got)
+		}
+	}
+
+	if !sqlValueString(row["z"]).IsNull() {
+		t.Error("expected null for SQL NULL")
+	}
+}
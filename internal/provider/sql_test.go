@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckSelectStatement(t *testing.T) {
+	testCases := map[string]string{
+		"SELECT * FROM dev.dogs":                          "",
+		"  select name FROM dev.dogs WHERE id = 1;  ":     "",
+		"-- comment\nSELECT 1":                            "",
+		"/* comment */ SELECT*FROM dev.dogs":              "",
+		"SELECT * FROM dev.dogs WHERE name = 'a;b'":       "",
+		"DELETE FROM dev.dogs":                            "only SELECT",
+		"SELECTED":                                        "only SELECT",
+		"":                                                "only SELECT",
+		"SELECT 1; DELETE FROM dev.dogs":                  "single statement",
+		"SELECT 1 -- ; \n; DROP TABLE dev.dogs":           "single statement",
+		"/* SELECT */ UPDATE dev.dogs SET name = 'x'":     "only SELECT",
+		"SELECT * FROM dev.dogs WHERE name = 'it''s'; --": "",
+	}
+
+	for query, want := range testCases {
+		err := checkSelectStatement(query)
+		if want == "" && err != nil {
+			t.Errorf("%q: unexpected error: %s", query, err)
+		}
+		if want != "" && (err == nil || !strings.Contains(err.Error(), want)) {
+			t.Errorf("%q: expected error containing %q, got %v", query, want, err)
+		}
+	}
+}
+
+func TestBindSQLParameters(t *testing.T) {
+	query, err := bindSQLParameters("SELECT * FROM dev.dogs WHERE name = ? AND note <> '?' AND owner = ? -- ?", []string{"Penny", "O'Brien"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := "SELECT * FROM dev.dogs WHERE name = 'Penny' AND note <> '?' AND owner = 'O''Brien' -- ?"
+	if query != want {
+		t.Errorf("expected %s, got %s", want, query)
+	}
+
+	if _, err := bindSQLParameters("SELECT ?", nil); err == nil {
+		t.Error("expected an error for a missing parameter")
+	}
+
+	if _, err := bindSQLParameters("SELECT 1", []string{"a"}); err == nil {
+		t.Error("expected an error for an unused parameter")
+	}
+
+	if _, err := bindSQLParameters("SELECT ?", []string{`a\`}); err == nil {
+		t.Error("expected an error for a parameter containing a backslash")
+	}
+}
+
+func TestLimitSQLQuery(t *testing.T) {
+	testCases := map[string]string{
+		"SELECT * FROM dev.dogs":                                                 "SELECT * FROM dev.dogs\nLIMIT 11",
+		"SELECT * FROM dev.dogs; ":                                               "SELECT * FROM dev.dogs \nLIMIT 11",
+		"SELECT * FROM dev.dogs -- all":                                          "SELECT * FROM dev.dogs -- all\nLIMIT 11",
+		"SELECT * FROM dev.dogs WHERE name = 'limit 5;'":                         "SELECT * FROM dev.dogs WHERE name = 'limit 5;'\nLIMIT 11",
+		"SELECT * FROM dev.dogs LIMIT 100;":                                      "SELECT * FROM dev.dogs LIMIT 11",
+		"SELECT * FROM dev.dogs ORDER BY id limit 5 -- x":                        "SELECT * FROM dev.dogs ORDER BY id limit 5 -- x",
+		"SELECT * FROM dev.dogs LIMIT 100 OFFSET 20":                             "SELECT * FROM dev.dogs LIMIT 11 OFFSET 20",
+		"SELECT * FROM dev.dogs LIMIT 20, 100":                                   "SELECT * FROM dev.dogs LIMIT 20, 11",
+		"SELECT * FROM dev.dogs WHERE id IN (SELECT id FROM dev.walks LIMIT 50)": "SELECT * FROM dev.dogs WHERE id IN (SELECT id FROM dev.walks LIMIT 50)\nLIMIT 11",
+		"SELECT sublimit FROM dev.dogs":                                          "SELECT sublimit FROM dev.dogs\nLIMIT 11",
+	}
+
+	for query, want := range testCases {
+		if got := limitSQLQuery(query, 11); got != want {
+			t.Errorf("%q: expected %q, got %q", query, want, got)
+		}
+	}
+}
@@ -0,0 +1,600 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Sections of the system_information response.
+var systemInformationSections = []string{
+	"system",
+	"time",
+	"cpu",
+	"memory",
+	"disk",
+	"network",
+	"harperdb_processes",
+	"table_size",
+	"replication",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SystemInformationDataSource{}
+
+func NewSystemInformationDataSource() datasource.DataSource {
+	return &SystemInformationDataSource{}
+}
+
+// SystemInformationDataSource defines the data source implementation.
+type SystemInformationDataSource struct {
+	client *harperdb.Client
+}
+
+// SystemInformationDataSourceModel describes the data source data model.
+type SystemInformationDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Sections          types.Set    `tfsdk:"sections"`
+	System            types.Object `tfsdk:"system"`
+	Time              types.Object `tfsdk:"time"`
+	CPU               types.Object `tfsdk:"cpu"`
+	Memory            types.Object `tfsdk:"memory"`
+	Disk              types.Object `tfsdk:"disk"`
+	Network           types.Object `tfsdk:"network"`
+	HarperDBProcesses types.String `tfsdk:"harperdb_processes"`
+	TableSize         types.List   `tfsdk:"table_size"`
+	Replication       types.String `tfsdk:"replication"`
+	JSON              types.String `tfsdk:"json"`
+}
+
+// SystemInformationSystemModel describes the system section.
+type SystemInformationSystemModel struct {
+	Platform    types.String `tfsdk:"platform"`
+	Distro      types.String `tfsdk:"distro"`
+	Release     types.String `tfsdk:"release"`
+	Kernel      types.String `tfsdk:"kernel"`
+	Arch        types.String `tfsdk:"arch"`
+	Hostname    types.String `tfsdk:"hostname"`
+	NodeVersion types.String `tfsdk:"node_version"`
+	NPMVersion  types.String `tfsdk:"npm_version"`
+	HDBVersion  types.String `tfsdk:"hdb_version"`
+}
+
+var systemInformationSystemAttrTypes = map[string]attr.Type{
+	"platform":     types.StringType,
+	"distro":       types.StringType,
+	"release":      types.StringType,
+	"kernel":       types.StringType,
+	"arch":         types.StringType,
+	"hostname":     types.StringType,
+	"node_version": types.StringType,
+	"npm_version":  types.StringType,
+	"hdb_version":  types.StringType,
+}
+
+// SystemInformationTimeModel describes the time section.
+type SystemInformationTimeModel struct {
+	Current      types.String  `tfsdk:"current"`
+	Uptime       types.Float64 `tfsdk:"uptime"`
+	Timezone     types.String  `tfsdk:"timezone"`
+	TimezoneName types.String  `tfsdk:"timezone_name"`
+}
+
+var systemInformationTimeAttrTypes = map[string]attr.Type{
+	"current":       types.StringType,
+	"uptime":        types.Float64Type,
+	"timezone":      types.StringType,
+	"timezone_name": types.StringType,
+}
+
+// SystemInformationCPUModel describes the cpu section.
+type SystemInformationCPUModel struct {
+	Manufacturer  types.String  `tfsdk:"manufacturer"`
+	Brand         types.String  `tfsdk:"brand"`
+	Speed         types.Float64 `tfsdk:"speed"`
+	Cores         types.Int64   `tfsdk:"cores"`
+	PhysicalCores types.Int64   `tfsdk:"physical_cores"`
+	CurrentLoad   types.Float64 `tfsdk:"current_load"`
+}
+
+var systemInformationCPUAttrTypes = map[string]attr.Type{
+	"manufacturer":   types.StringType,
+	"brand":          types.StringType,
+	"speed":          types.Float64Type,
+	"cores":          types.Int64Type,
+	"physical_cores": types.Int64Type,
+	"current_load":   types.Float64Type,
+}
+
+// SystemInformationMemoryModel describes the memory section.
+type SystemInformationMemoryModel struct {
+	Total     types.Int64 `tfsdk:"total"`
+	Free      types.Int64 `tfsdk:"free"`
+	Used      types.Int64 `tfsdk:"used"`
+	Active    types.Int64 `tfsdk:"active"`
+	Available types.Int64 `tfsdk:"available"`
+	SwapTotal types.Int64 `tfsdk:"swap_total"`
+	SwapUsed  types.Int64 `tfsdk:"swap_used"`
+	SwapFree  types.Int64 `tfsdk:"swap_free"`
+}
+
+var systemInformationMemoryAttrTypes = map[string]attr.Type{
+	"total":      types.Int64Type,
+	"free":       types.Int64Type,
+	"used":       types.Int64Type,
+	"active":     types.Int64Type,
+	"available":  types.Int64Type,
+	"swap_total": types.Int64Type,
+	"swap_used":  types.Int64Type,
+	"swap_free":  types.Int64Type,
+}
+
+// SystemInformationDiskModel describes the disk section.
+type SystemInformationDiskModel struct {
+	Filesystems types.List `tfsdk:"filesystems"`
+}
+
+// SystemInformationFilesystemModel describes a single entry of filesystems.
+type SystemInformationFilesystemModel struct {
+	FS        types.String  `tfsdk:"fs"`
+	Type      types.String  `tfsdk:"type"`
+	Mount     types.String  `tfsdk:"mount"`
+	Size      types.Int64   `tfsdk:"size"`
+	Used      types.Int64   `tfsdk:"used"`
+	Available types.Int64   `tfsdk:"available"`
+	Use       types.Float64 `tfsdk:"use"`
+}
+
+var systemInformationFilesystemAttrTypes = map[string]attr.Type{
+	"fs":        types.StringType,
+	"type":      types.StringType,
+	"mount":     types.StringType,
+	"size":      types.Int64Type,
+	"used":      types.Int64Type,
+	"available": types.Int64Type,
+	"use":       types.Float64Type,
+}
+
+var systemInformationDiskAttrTypes = map[string]attr.Type{
+	"filesystems": types.ListType{ElemType: types.ObjectType{AttrTypes: systemInformationFilesystemAttrTypes}},
+}
+
+// SystemInformationNetworkModel describes the network section.
+type SystemInformationNetworkModel struct {
+	DefaultInterface types.String `tfsdk:"default_interface"`
+	Interfaces       types.List   `tfsdk:"interfaces"`
+}
+
+// SystemInformationInterfaceModel describes a single entry of interfaces.
+type SystemInformationInterfaceModel struct {
+	Iface     types.String `tfsdk:"iface"`
+	IP4       types.String `tfsdk:"ip4"`
+	IP6       types.String `tfsdk:"ip6"`
+	Mac       types.String `tfsdk:"mac"`
+	OperState types.String `tfsdk:"operstate"`
+}
+
+var systemInformationInterfaceAttrTypes = map[string]attr.Type{
+	"iface":     types.StringType,
+	"ip4":       types.StringType,
+	"ip6":       types.StringType,
+	"mac":       types.StringType,
+	"operstate": types.StringType,
+}
+
+var systemInformationNetworkAttrTypes = map[string]attr.Type{
+	"default_interface": types.StringType,
+	"interfaces":        types.ListType{ElemType: types.ObjectType{AttrTypes: systemInformationInterfaceAttrTypes}},
+}
+
+// SystemInformationTableSizeModel describes a single entry of table_size.
+type SystemInformationTableSizeModel struct {
+	Schema                    types.String `tfsdk:"schema"`
+	Table                     types.String `tfsdk:"table"`
+	TableSize                 types.Int64  `tfsdk:"table_size"`
+	RecordCount               types.Int64  `tfsdk:"record_count"`
+	TransactionLogSize        types.Int64  `tfsdk:"transaction_log_size"`
+	TransactionLogRecordCount types.Int64  `tfsdk:"transaction_log_record_count"`
+}
+
+var systemInformationTableSizeAttrTypes = map[string]attr.Type{
+	"schema":                       types.StringType,
+	"table":                        types.StringType,
+	"table_size":                   types.Int64Type,
+	"record_count":                 types.Int64Type,
+	"transaction_log_size":         types.Int64Type,
+	"transaction_log_record_count": types.Int64Type,
+}
+
+func (d *SystemInformationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_information"
+}
+
+func (d *SystemInformationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reports the version, resources and usage of the HarperDB instance. " +
+			"Sections which were not requested, or which the instance does not report, are null.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Sorted requested sections separated by `,`",
+				Computed:            true,
+			},
+			"sections": schema.SetAttribute{
+				MarkdownDescription: "Sections to request, any of `" + strings.Join(systemInformationSections, "`, `") + "`. Defaults to all sections.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(systemInformationSections...)),
+				},
+			},
+			"system": schema.SingleNestedAttribute{
+				MarkdownDescription: "Operating system and software versions",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"platform":     schema.StringAttribute{MarkdownDescription: "Operating system platform", Computed: true},
+					"distro":       schema.StringAttribute{MarkdownDescription: "Operating system distribution", Computed: true},
+					"release":      schema.StringAttribute{MarkdownDescription: "Operating system release", Computed: true},
+					"kernel":       schema.StringAttribute{MarkdownDescription: "Kernel version", Computed: true},
+					"arch":         schema.StringAttribute{MarkdownDescription: "CPU architecture", Computed: true},
+					"hostname":     schema.StringAttribute{MarkdownDescription: "Hostname", Computed: true},
+					"node_version": schema.StringAttribute{MarkdownDescription: "Node.js version", Computed: true},
+					"npm_version":  schema.StringAttribute{MarkdownDescription: "npm version", Computed: true},
+					"hdb_version":  schema.StringAttribute{MarkdownDescription: "HarperDB version", Computed: true},
+				},
+			},
+			"time": schema.SingleNestedAttribute{
+				MarkdownDescription: "Clock of the instance",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"current":       schema.StringAttribute{MarkdownDescription: "Current time in RFC 3339 format", Computed: true},
+					"uptime":        schema.Float64Attribute{MarkdownDescription: "Uptime of the host in seconds", Computed: true},
+					"timezone":      schema.StringAttribute{MarkdownDescription: "Timezone offset", Computed: true},
+					"timezone_name": schema.StringAttribute{MarkdownDescription: "Timezone name", Computed: true},
+				},
+			},
+			"cpu": schema.SingleNestedAttribute{
+				MarkdownDescription: "Processor and load",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"manufacturer":   schema.StringAttribute{MarkdownDescription: "Processor manufacturer", Computed: true},
+					"brand":          schema.StringAttribute{MarkdownDescription: "Processor brand", Computed: true},
+					"speed":          schema.Float64Attribute{MarkdownDescription: "Processor speed in GHz", Computed: true},
+					"cores":          schema.Int64Attribute{MarkdownDescription: "Number of logical cores", Computed: true},
+					"physical_cores": schema.Int64Attribute{MarkdownDescription: "Number of physical cores", Computed: true},
+					"current_load":   schema.Float64Attribute{MarkdownDescription: "Current load in percent", Computed: true},
+				},
+			},
+			"memory": schema.SingleNestedAttribute{
+				MarkdownDescription: "Memory usage in bytes",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"total":      schema.Int64Attribute{MarkdownDescription: "Total memory", Computed: true},
+					"free":       schema.Int64Attribute{MarkdownDescription: "Free memory", Computed: true},
+					"used":       schema.Int64Attribute{MarkdownDescription: "Used memory, including buffers and caches", Computed: true},
+					"active":     schema.Int64Attribute{MarkdownDescription: "Used memory, excluding buffers and caches", Computed: true},
+					"available":  schema.Int64Attribute{MarkdownDescription: "Memory available to applications", Computed: true},
+					"swap_total": schema.Int64Attribute{MarkdownDescription: "Total swap", Computed: true},
+					"swap_used":  schema.Int64Attribute{MarkdownDescription: "Used swap", Computed: true},
+					"swap_free":  schema.Int64Attribute{MarkdownDescription: "Free swap", Computed: true},
+				},
+			},
+			"disk": schema.SingleNestedAttribute{
+				MarkdownDescription: "Disk usage",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"filesystems": schema.ListNestedAttribute{
+						MarkdownDescription: "Mounted filesystems",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"fs":        schema.StringAttribute{MarkdownDescription: "Device of the filesystem", Computed: true},
+								"type":      schema.StringAttribute{MarkdownDescription: "Type of the filesystem", Computed: true},
+								"mount":     schema.StringAttribute{MarkdownDescription: "Mount point", Computed: true},
+								"size":      schema.Int64Attribute{MarkdownDescription: "Size in bytes", Computed: true},
+								"used":      schema.Int64Attribute{MarkdownDescription: "Used bytes", Computed: true},
+								"available": schema.Int64Attribute{MarkdownDescription: "Available bytes", Computed: true},
+								"use":       schema.Float64Attribute{MarkdownDescription: "Usage in percent", Computed: true},
+							},
+						},
+					},
+				},
+			},
+			"network": schema.SingleNestedAttribute{
+				MarkdownDescription: "Network interfaces",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"default_interface": schema.StringAttribute{MarkdownDescription: "Name of the default interface", Computed: true},
+					"interfaces": schema.ListNestedAttribute{
+						MarkdownDescription: "Network interfaces",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"iface":     schema.StringAttribute{MarkdownDescription: "Name of the interface", Computed: true},
+								"ip4":       schema.StringAttribute{MarkdownDescription: "IPv4 address", Computed: true},
+								"ip6":       schema.StringAttribute{MarkdownDescription: "IPv6 address", Computed: true},
+								"mac":       schema.StringAttribute{MarkdownDescription: "MAC address", Computed: true},
+								"operstate": schema.StringAttribute{MarkdownDescription: "Operational state", Computed: true},
+							},
+						},
+					},
+				},
+			},
+			"harperdb_processes": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded HarperDB processes, whose structure depends on the HarperDB version",
+				Computed:            true,
+			},
+			"table_size": schema.ListNestedAttribute{
+				MarkdownDescription: "Storage used by each table",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"schema":                       schema.StringAttribute{MarkdownDescription: "Schema of the table", Computed: true},
+						"table":                        schema.StringAttribute{MarkdownDescription: "Name of the table", Computed: true},
+						"table_size":                   schema.Int64Attribute{MarkdownDescription: "Size of the table in bytes", Computed: true},
+						"record_count":                 schema.Int64Attribute{MarkdownDescription: "Number of records", Computed: true},
+						"transaction_log_size":         schema.Int64Attribute{MarkdownDescription: "Size of the transaction log in bytes", Computed: true},
+						"transaction_log_record_count": schema.Int64Attribute{MarkdownDescription: "Number of transaction log records", Computed: true},
+					},
+				},
+			},
+			"replication": schema.StringAttribute{
+				MarkdownDescription: "JSON encoded replication status (HarperDB 4.x), whose structure depends on the HarperDB version",
+				Computed:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Complete response, including fields without a dedicated attribute",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SystemInformationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SystemInformationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemInformationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sections []string
+	if !data.Sections.IsNull() {
+		resp.Diagnostics.Append(data.Sections.ElementsAs(ctx, &sections, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var raw json.RawMessage
+	err := d.client.RawRequest(opSystemInformation{Attributes: sections}, &raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system information, got error: %s", err))
+		return
+	}
+
+	var info systemInformationResponse
+	if err := json.Unmarshal(raw, &info); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode system information, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(flattenSystemInformation(ctx, info, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(systemInformationID(sections))
+	data.JSON = types.StringValue(string(raw))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// systemInformationID returns the sorted sections separated by ",". No
+// sections stands for all of them.
+func systemInformationID(sections []string) string {
+	if len(sections) == 0 {
+		sections = systemInformationSections
+	}
+
+	sorted := append([]string(nil), sections...)
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, ",")
+}
+
+// flattenSystemInformation sets the section attributes of data. Sections
+// missing from the response are set to null.
+func flattenSystemInformation(ctx context.Context, info systemInformationResponse, data *SystemInformationDataSourceModel) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.System = types.ObjectNull(systemInformationSystemAttrTypes)
+	if s := info.System; s != nil {
+		data.System, d = types.ObjectValueFrom(ctx, systemInformationSystemAttrTypes, SystemInformationSystemModel{
+			Platform:    types.StringValue(s.Platform),
+			Distro:      types.StringValue(s.Distro),
+			Release:     types.StringValue(s.Release),
+			Kernel:      types.StringValue(s.Kernel),
+			Arch:        types.StringValue(s.Arch),
+			Hostname:    types.StringValue(s.Hostname),
+			NodeVersion: types.StringValue(s.NodeVersion),
+			NPMVersion:  types.StringValue(s.NPMVersion),
+			HDBVersion:  types.StringValue(s.HDBVersion),
+		})
+		diags.Append(d...)
+	}
+
+	data.Time = types.ObjectNull(systemInformationTimeAttrTypes)
+	if t := info.Time; t != nil {
+		data.Time, d = types.ObjectValueFrom(ctx, systemInformationTimeAttrTypes, SystemInformationTimeModel{
+			Current:      types.StringValue(formatTimestamp(t.Current)),
+			Uptime:       types.Float64Value(t.Uptime),
+			Timezone:     types.StringValue(t.Timezone),
+			TimezoneName: types.StringValue(t.TimezoneName),
+		})
+		diags.Append(d...)
+	}
+
+	data.CPU = types.ObjectNull(systemInformationCPUAttrTypes)
+	if c := info.CPU; c != nil {
+		data.CPU, d = types.ObjectValueFrom(ctx, systemInformationCPUAttrTypes, SystemInformationCPUModel{
+			Manufacturer:  types.StringValue(c.Manufacturer),
+			Brand:         types.StringValue(c.Brand),
+			Speed:         types.Float64Value(c.Speed),
+			Cores:         types.Int64Value(int64(c.Cores)),
+			PhysicalCores: types.Int64Value(int64(c.PhysicalCores)),
+			CurrentLoad:   currentLoad(c.CurrentLoad),
+		})
+		diags.Append(d...)
+	}
+
+	data.Memory = types.ObjectNull(systemInformationMemoryAttrTypes)
+	if m := info.Memory; m != nil {
+		data.Memory, d = types.ObjectValueFrom(ctx, systemInformationMemoryAttrTypes, SystemInformationMemoryModel{
+			Total:     types.Int64Value(int64(m.Total)),
+			Free:      types.Int64Value(int64(m.Free)),
+			Used:      types.Int64Value(int64(m.Used)),
+			Active:    types.Int64Value(int64(m.Active)),
+			Available: types.Int64Value(int64(m.Available)),
+			SwapTotal: types.Int64Value(int64(m.SwapTotal)),
+			SwapUsed:  types.Int64Value(int64(m.SwapUsed)),
+			SwapFree:  types.Int64Value(int64(m.SwapFree)),
+		})
+		diags.Append(d...)
+	}
+
+	data.Disk = types.ObjectNull(systemInformationDiskAttrTypes)
+	if disk := info.Disk; disk != nil {
+		filesystems := make([]SystemInformationFilesystemModel, 0, len(disk.Size))
+		for _, fs := range disk.Size {
+			filesystems = append(filesystems, SystemInformationFilesystemModel{
+				FS:        types.StringValue(fs.FS),
+				Type:      types.StringValue(fs.Type),
+				Mount:     types.StringValue(fs.Mount),
+				Size:      types.Int64Value(int64(fs.Size)),
+				Used:      types.Int64Value(int64(fs.Used)),
+				Available: types.Int64Value(int64(fs.Available)),
+				Use:       types.Float64Value(fs.Use),
+			})
+		}
+
+		filesystemsValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: systemInformationFilesystemAttrTypes}, filesystems)
+		diags.Append(d...)
+		data.Disk, d = types.ObjectValueFrom(ctx, systemInformationDiskAttrTypes, SystemInformationDiskModel{
+			Filesystems: filesystemsValue,
+		})
+		diags.Append(d...)
+	}
+
+	data.Network = types.ObjectNull(systemInformationNetworkAttrTypes)
+	if n := info.Network; n != nil {
+		interfaces := make([]SystemInformationInterfaceModel, 0, len(n.Interfaces))
+		for _, i := range n.Interfaces {
+			interfaces = append(interfaces, SystemInformationInterfaceModel{
+				Iface:     types.StringValue(i.Iface),
+				IP4:       types.StringValue(i.IP4),
+				IP6:       types.StringValue(i.IP6),
+				Mac:       types.StringValue(i.Mac),
+				OperState: types.StringValue(i.OperState),
+			})
+		}
+
+		interfacesValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: systemInformationInterfaceAttrTypes}, interfaces)
+		diags.Append(d...)
+		data.Network, d = types.ObjectValueFrom(ctx, systemInformationNetworkAttrTypes, SystemInformationNetworkModel{
+			DefaultInterface: types.StringValue(n.DefaultInterface),
+			Interfaces:       interfacesValue,
+		})
+		diags.Append(d...)
+	}
+
+	data.TableSize = types.ListNull(types.ObjectType{AttrTypes: systemInformationTableSizeAttrTypes})
+	if info.TableSize != nil {
+		tables := make([]SystemInformationTableSizeModel, 0, len(*info.TableSize))
+		for _, t := range *info.TableSize {
+			tables = append(tables, SystemInformationTableSizeModel{
+				Schema:                    types.StringValue(t.Schema),
+				Table:                     types.StringValue(t.Table),
+				TableSize:                 types.Int64Value(int64(t.TableSize)),
+				RecordCount:               types.Int64Value(int64(t.RecordCount)),
+				TransactionLogSize:        types.Int64Value(int64(t.TransactionLogSize)),
+				TransactionLogRecordCount: types.Int64Value(int64(t.TransactionLogRecordCount)),
+			})
+		}
+
+		data.TableSize, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: systemInformationTableSizeAttrTypes}, tables)
+		diags.Append(d...)
+	}
+
+	data.HarperDBProcesses = rawJSONValue(info.HarperDBProcesses)
+	data.Replication = rawJSONValue(info.Replication)
+
+	return diags
+}
+
+// currentLoad returns the overall CPU load, which is reported as currentLoad
+// or, by older HarperDB versions, as currentload.
+func currentLoad(load map[string]interface{}) types.Float64 {
+	for _, key := range []string{"currentLoad", "currentload"} {
+		if v, ok := load[key].(float64); ok {
+			return types.Float64Value(v)
+		}
+	}
+
+	return types.Float64Null()
+}
+
+// rawJSONValue returns the compacted JSON of a section without a fixed
+// structure, or null when the section is missing.
+func rawJSONValue(raw json.RawMessage) types.String {
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull()
+	}
+
+	var b bytes.Buffer
+	if err := json.Compact(&b, raw); err != nil {
+		return types.StringValue(string(raw))
+	}
+
+	return types.StringValue(b.String())
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSystemInformationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_system_information" "test" {
+  sections = ["system", "memory"]
+}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harperdb_system_information.test", "id", "memory,system"),
+					resource.TestMatchResourceAttr("data.harperdb_system_information.test", "system.hdb_version", regexp.MustCompile(`^\d+\.\d+`)),
+					resource.TestCheckResourceAttrSet("data.harperdb_system_information.test", "memory.total"),
+					resource.TestCheckNoResourceAttr("data.harperdb_system_information.test", "time"),
+					resource.TestCheckNoResourceAttr("data.harperdb_system_information.test", "table_size"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_system_information" "test" {
+  sections = ["memory", "uptime"]
+}
+`, testAccProviderTF()),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestFlattenSystemInformation(t *testing.T) {
+	var info systemInformationResponse
+	err := json.Unmarshal([]byte(`{
+		"system": {"platform": "linux", "hostname": "hdb", "hdb_version": "4.1.0"},
+		"time": {"current": 1682000000000, "uptime": 12.5, "timezone": "+00:00", "timezoneName": "Etc/UTC"},
+		"cpu": {"cores": 4, "physicalCores": 2, "current_load": {"currentLoad": 12.5}},
+		"memory": {"total": 8589934592, "available": 4294967296},
+		"disk": {"size": [{"fs": "/dev/sda1", "type": "ext4", "mount": "/", "size": 100, "used": 25, "available": 75, "use": 25}]},
+		"harperdb_processes": {"core": [{"pid": 1}], "clustering": []},
+		"table_size": []
+	}`), &info)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var data SystemInformationDataSourceModel
+	if diags := flattenSystemInformation(context.Background(), info, &data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := data.System.Attributes()["hdb_version"].String(); got != `"4.1.0"` {
+		t.Errorf("expected hdb_version 4.1.0, got %s", got)
+	}
+
+	if got := data.Time.Attributes()["current"].String(); got != `"2023-04-20T14:13:20Z"` {
+		t.Errorf("expected current time 2023-04-20T14:13:20Z, got %s", got)
+	}
+
+	if got := data.CPU.Attributes()["current_load"].String(); got != "12.500000" {
+		t.Errorf("expected current_load 12.5, got %s", got)
+	}
+
+	if got := data.Memory.Attributes()["total"].String(); got != "8589934592" {
+		t.Errorf("expected total memory 8589934592, got %s", got)
+	}
+
+	if !data.Network.IsNull() || !data.Replication.IsNull() {
+		t.Errorf("expected missing sections to be null, got network %s and replication %s", data.Network, data.Replication)
+	}
+
+	if data.TableSize.IsNull() || len(data.TableSize.Elements()) != 0 {
+		t.Errorf("expected an empty table_size, got %s", data.TableSize)
+	}
+
+	if got := data.HarperDBProcesses.ValueString(); got != `{"core":[{"pid":1}],"clustering":[]}` {
+		t.Errorf("unexpected harperdb_processes %s", got)
+	}
+}
+
+func TestSystemInformationID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sections []string
+		expected string
+	}{
+		{
+			name:     "all sections",
+			expected: "cpu,disk,harperdb_processes,memory,network,replication,system,table_size,time",
+		},
+		{
+			name:     "requested sections",
+			sections: []string{"time", "cpu", "system"},
+			expected: "cpu,system,time",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := systemInformationID(tc.sections); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+
+	if systemInformationSections[0] != "system" {
+		t.Errorf("expected the default sections to stay unsorted, got %v", systemInformationSections)
+	}
+}
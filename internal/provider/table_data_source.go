@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TableDataSource{}
+
+func NewTableDataSource() datasource.DataSource {
+	return &TableDataSource{}
+}
+
+// TableDataSource defines the data source implementation.
+type TableDataSource struct {
+	client *harperdb.Client
+}
+
+// TableDataSourceModel describes the data source data model.
+type TableDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"` // <schema>.<name>
+	Schema               types.String `tfsdk:"schema"`
+	Name                 types.String `tfsdk:"name"`
+	HashAttribute        types.String `tfsdk:"hash_attribute"`
+	Attributes           types.List   `tfsdk:"attributes"`
+	RecordCount          types.Int64  `tfsdk:"record_count"`
+	ClusteringStreamName types.String `tfsdk:"clustering_stream_name"`
+	CreatedTime          types.String `tfsdk:"created_time"`
+	LastUpdated          types.String `tfsdk:"last_updated"`
+}
+
+func (d *TableDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_table"
+}
+
+func (d *TableDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up a table and its attributes",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Schema and table name separated by `.`",
+				Computed:            true,
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Schema of the table",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the table",
+				Required:            true,
+			},
+			"hash_attribute": schema.StringAttribute{
+				MarkdownDescription: "Primary key of the table",
+				Computed:            true,
+			},
+			"attributes": schema.ListAttribute{
+				MarkdownDescription: "Sorted attribute names, including the hash attribute and the timestamps maintained by HarperDB",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"record_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of records",
+				Computed:            true,
+			},
+			"clustering_stream_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the clustering stream of the table (HarperDB 4.x)",
+				Computed:            true,
+			},
+			"created_time": schema.StringAttribute{
+				MarkdownDescription: "Creation time of the table in RFC 3339 format",
+				Computed:            true,
+			},
+			"last_updated": schema.StringAttribute{
+				MarkdownDescription: "Time of the last record update, or of the table itself while it holds no records, in RFC 3339 format",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TableDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TableDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaName, name := data.Schema.ValueString(), data.Name.ValueString()
+
+	var table describeTableResponse
+	err := d.client.RawRequest(opDescribeTable{Schema: schemaName, Table: name}, &table)
+	if err != nil {
+		if isDoesNotExistError(err) {
+			resp.Diagnostics.AddError("Table Not Found", fmt.Sprintf("No table %q exists in schema %q.", name, schemaName))
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to describe table, got error: %s", err))
+		return
+	}
+
+	attributes := make([]string, 0, len(table.Attributes))
+	for _, attribute := range table.Attributes {
+		attributes = append(attributes, attribute.Attribute)
+	}
+	sort.Strings(attributes)
+
+	attributesValue, diags := types.ListValueFrom(ctx, types.StringType, attributes)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tables without records only carry the update time of the table itself.
+	lastUpdated := table.LastUpdatedRecord
+	if lastUpdated == 0 {
+		lastUpdated = float64(table.UpdatedTime)
+	}
+
+	data.ID = types.StringValue(schemaName + "." + name)
+	data.HashAttribute = types.StringValue(table.HashAttribute)
+	data.Attributes = attributesValue
+	data.RecordCount = types.Int64Value(int64(table.RecordCount))
+	data.ClusteringStreamName = types.StringValue(table.ClusteringStreamName)
+	data.CreatedTime = types.StringValue(formatTimestamp(float64(table.CreatedTime)))
+	data.LastUpdated = types.StringValue(formatTimestamp(lastUpdated))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
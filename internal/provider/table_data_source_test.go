@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTableDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_schema" "test" {
+  name = "tf_acc_table_ds"
+}
+
+resource "harperdb_table" "test" {
+  schema         = harperdb_schema.test.name
+  name           = "dogs"
+  hash_attribute = "dog_id"
+}
+
+data "harperdb_table" "test" {
+  schema = harperdb_table.test.schema
+  name   = harperdb_table.test.name
+}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harperdb_table.test", "id", "tf_acc_table_ds.dogs"),
+					resource.TestCheckResourceAttr("data.harperdb_table.test", "hash_attribute", "dog_id"),
+					resource.TestCheckResourceAttr("data.harperdb_table.test", "record_count", "0"),
+					resource.TestCheckTypeSetElemAttr("data.harperdb_table.test", "attributes.*", "dog_id"),
+					resource.TestCheckResourceAttrSet("data.harperdb_table.test", "created_time"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_table" "missing" {
+  schema = "tf_acc_table_ds_missing"
+  name   = "dogs"
+}
+`, testAccProviderTF()),
+				ExpectError: regexp.MustCompile("Table Not Found"),
+			},
+		},
+	})
+}
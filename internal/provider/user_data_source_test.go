@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_role" "test" {
+  name = "tf_acc_user_ds"
+}
+
+resource "harperdb_user" "test" {
+  username = "tf_acc_user_ds"
+  password = "tf_acc_user_ds"
+  role     = harperdb_role.test.name
+}
+
+data "harperdb_user" "test" {
+  username = harperdb_user.test.username
+}
+
+data "harperdb_user" "current" {}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harperdb_user.test", "role", "tf_acc_user_ds"),
+					resource.TestCheckResourceAttrPair("data.harperdb_user.test", "role_id", "harperdb_role.test", "id"),
+					resource.TestCheckResourceAttr("data.harperdb_user.test", "active", "true"),
+					resource.TestCheckNoResourceAttr("data.harperdb_user.test", "password"),
+					resource.TestCheckResourceAttrSet("data.harperdb_user.current", "username"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+data "harperdb_user" "missing" {
+  username = "tf_acc_user_ds_missing"
+}
+`, testAccProviderTF()),
+				ExpectError: regexp.MustCompile("User Not Found"),
+			},
+		},
+	})
+}
+
+// TestFindUser ensures that an omitted username falls back to the user the
+// client is authenticated as.
+func TestFindUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var op struct {
+			Operation string `json:"operation"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&op); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch op.Operation {
+		case harperdb.OP_USER_INFO:
+			w.Write([]byte(`{"username": "admin", "active": true, "role": {"id": "1", "role": "super_user"}}`))
+		case harperdb.OP_LIST_USERS:
+			w.Write([]byte(`[{"username": "ada", "active": true, "role": {"id": "2", "role": "dev"}}]`))
+		default:
+			t.Errorf("unexpected operation %q", op.Operation)
+		}
+	}))
+	defer server.Close()
+
+	client := harperdb.NewClient(server.URL, "admin", "password")
+
+	testCases := map[string]struct {
+		username string
+		want     string
+	}{
+		"current": {
+			want: "admin",
+		},
+		"username": {
+			username: "ada",
+			want:     "ada",
+		},
+		"missing": {
+			username: "grace",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			user, err := findUser(client, tc.username)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got string
+			if user != nil {
+				got = user.Username
+			}
+			if got != tc.want {
+				t.Errorf("expected user %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
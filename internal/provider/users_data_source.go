@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *harperdb.Client
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Role      types.String `tfsdk:"role"`
+	Active    types.Bool   `tfsdk:"active"`
+	Usernames types.List   `tfsdk:"usernames"`
+	Users     types.List   `tfsdk:"users"`
+}
+
+// UsersUserModel describes a single entry of users.
+type UsersUserModel struct {
+	Username types.String `tfsdk:"username"`
+	Role     types.String `tfsdk:"role"`
+	RoleID   types.String `tfsdk:"role_id"`
+	Active   types.Bool   `tfsdk:"active"`
+}
+
+var usersUserAttrTypes = map[string]attr.Type{
+	"username": types.StringType,
+	"role":     types.StringType,
+	"role_id":  types.StringType,
+	"active":   types.BoolType,
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists users. Password hashes are never exposed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Hash of the matching usernames",
+				Computed:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Only return users assigned the role with this name",
+				Optional:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only return active (`true`) or inactive (`false`) users",
+				Optional:            true,
+			},
+			"usernames": schema.ListAttribute{
+				MarkdownDescription: "Sorted usernames of the matching users",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching users sorted by username",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							MarkdownDescription: "Username",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Name of the role assigned to the user",
+							Computed:            true,
+						},
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the role assigned to the user",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Is the account active",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*harperdb.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *harperdb.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListUsers()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
+		return
+	}
+
+	users = filterUsers(users, data.Role, data.Active)
+
+	usernames := make([]string, 0, len(users))
+	models := make([]UsersUserModel, 0, len(users))
+	for _, user := range users {
+		usernames = append(usernames, user.Username)
+		models = append(models, UsersUserModel{
+			Username: types.StringValue(user.Username),
+			Role:     types.StringValue(user.Role.Role),
+			RoleID:   types.StringValue(user.Role.ID),
+			Active:   types.BoolValue(user.Active),
+		})
+	}
+
+	usernamesValue, diags := types.ListValueFrom(ctx, types.StringType, usernames)
+	resp.Diagnostics.Append(diags...)
+	usersValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: usersUserAttrTypes}, models)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(usernames, ",")))))
+	data.Usernames = usernamesValue
+	data.Users = usersValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterUsers returns the users matching the role name and active filters,
+// sorted by username. Null filters match every user.
+func filterUsers(users []harperdb.User, role types.String, active types.Bool) []harperdb.User {
+	matching := []harperdb.User{}
+	for _, user := range users {
+		if !role.IsNull() && user.Role.Role != role.ValueString() {
+			continue
+		}
+		if !active.IsNull() && user.Active != active.ValueBool() {
+			continue
+		}
+		matching = append(matching, user)
+	}
+
+	sort.Slice(matching, func(i, j int) bool {
+		return matching[i].Username < matching[j].Username
+	})
+
+	return matching
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	harperdb "github.com/HarperDB-Add-Ons/sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+	%s
+
+resource "harperdb_role" "test" {
+  name = "tf_acc_users_ds"
+}
+
+resource "harperdb_user" "active" {
+  username = "tf_acc_users_ds_active"
+  password = "tf_acc_users_ds"
+  role     = harperdb_role.test.name
+}
+
+resource "harperdb_user" "inactive" {
+  username = "tf_acc_users_ds_inactive"
+  password = "tf_acc_users_ds"
+  role     = harperdb_role.test.name
+  active   = false
+}
+
+data "harperdb_users" "test" {
+  role   = harperdb_role.test.name
+  active = true
+
+  depends_on = [harperdb_user.active, harperdb_user.inactive]
+}
+`, testAccProviderTF()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.harperdb_users.test", "usernames.#", "1"),
+					resource.TestCheckResourceAttr("data.harperdb_users.test", "users.0.username", "tf_acc_users_ds_active"),
+					resource.TestCheckResourceAttr("data.harperdb_users.test", "users.0.role", "tf_acc_users_ds"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterUsers(t *testing.T) {
+	users := []harperdb.User{
+		{Username: "grace", Active: true, Role: harperdb.Role{Role: "dev"}},
+		{Username: "ada", Active: true, Role: harperdb.Role{Role: "dev"}},
+		{Username: "linus", Active: false, Role: harperdb.Role{Role: "dev"}},
+		{Username: "ken", Active: true, Role: harperdb.Role{Role: "ops"}},
+	}
+
+	testCases := map[string]struct {
+		role   types.String
+		active types.Bool
+		want   []string
+	}{
+		"no-filter": {
+			role:   types.StringNull(),
+			active: types.BoolNull(),
+			want:   []string{"ada", "grace", "ken", "linus"},
+		},
+		"role": {
+			role:   types.StringValue("dev"),
+			active: types.BoolNull(),
+			want:   []string{"ada", "grace", "linus"},
+		},
+		"role-and-inactive": {
+			role:   types.StringValue("dev"),
+			active: types.BoolValue(false),
+			want:   []string{"linus"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			got := filterUsers(users, tc.role, tc.active)
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected %v, got %+v", tc.want, got)
+			}
+			for i, username := range tc.want {
+				if got[i].Username != username {
+					t.Errorf("expected %v, got %+v", tc.want, got)
+				}
+			}
+		})
+	}
+}